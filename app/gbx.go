@@ -12,6 +12,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// How long a connection test may take before it is reported as timed out
+const testConnectionTimeout = 10 * time.Second
+
 type Client struct {
 	Server *structs.Server
 }
@@ -30,6 +33,7 @@ func GetClient(server *structs.Server) error {
 	listeners.AddPlayersListeners(server)
 	listeners.AddLiveListeners(server)
 	listeners.AddChatListeners(server)
+	listeners.AddScriptListeners(server)
 
 	if err := ConnectClient(server); err != nil {
 		return err
@@ -77,11 +81,68 @@ func ConnectClient(server *structs.Server) error {
 	server.Info.Chat.ManualRouting = false
 
 	listeners.SyncPlayerList(server)
-	listeners.SyncLiveInfo(server)
+	listeners.SyncLiveInfo(server, nil)
 
 	return nil
 }
 
+// TestConnection connects and authenticates with a separate client, the server is not saved or kept connected
+func TestConnection(server *structs.Server) structs.ServerTestResult {
+	type outcome struct {
+		connected bool
+		err       error
+	}
+
+	client := gbxclient.NewGbxClient(server.Host, server.XMLRPCPort, gbxclient.Options{})
+	start := time.Now()
+
+	ctx, cancel := context.WithTimeout(context.Background(), testConnectionTimeout)
+	defer cancel()
+
+	done := make(chan outcome, 1)
+	go func() {
+		if err := client.Connect(); err != nil {
+			done <- outcome{err: err}
+			return
+		}
+
+		// The test timed out while connecting, nobody waits for the result anymore
+		if ctx.Err() != nil {
+			client.Disconnect()
+			return
+		}
+
+		// Disconnecting when the test times out stops an authentication that doesn't get a response
+		stop := context.AfterFunc(ctx, func() { client.Disconnect() })
+		err := client.Authenticate(server.User, server.Pass)
+		if stop() {
+			client.Disconnect()
+		}
+		done <- outcome{connected: true, err: err}
+	}()
+
+	select {
+	case result := <-done:
+		testResult := structs.ServerTestResult{
+			Connected:     result.connected,
+			Authenticated: result.connected && result.err == nil,
+			Latency:       time.Since(start).Milliseconds(),
+		}
+		if result.err != nil {
+			testResult.Error = result.err.Error()
+		}
+		zap.L().Info("Tested server connection", zap.String("host", server.Host), zap.Int("port", server.XMLRPCPort), zap.Bool("authenticated", testResult.Authenticated))
+		return testResult
+
+	case <-ctx.Done():
+		zap.L().Info("Server connection test timed out", zap.String("host", server.Host), zap.Int("port", server.XMLRPCPort))
+		return structs.ServerTestResult{
+			Latency: time.Since(start).Milliseconds(),
+			Error:   "connection timed out",
+		}
+	}
+}
+
 func StartReconnectLoop(ctx context.Context, server *structs.Server) {
 	go func() {
 		ticker := time.NewTicker(config.AppEnv.ReconnectInterval)
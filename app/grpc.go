@@ -0,0 +1,50 @@
+package app
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/middleware"
+	"github.com/MRegterschot/GbxConnector/pb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var grpcServer *grpc.Server
+
+// Starts the gRPC server when a gRPC port is configured
+func StartGrpcServer() {
+	if config.AppEnv.GrpcPort == 0 {
+		return
+	}
+
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(config.AppEnv.GrpcPort))
+	if err != nil {
+		zap.L().Error("Failed to listen for gRPC", zap.Int("port", config.AppEnv.GrpcPort), zap.Error(err))
+		return
+	}
+
+	grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.GrpcUnaryAuth),
+		grpc.StreamInterceptor(middleware.GrpcStreamAuth),
+	)
+	pb.RegisterGbxConnectorServer(grpcServer, handlers.NewGrpcServer())
+
+	go func() {
+		zap.L().Info("Starting gRPC server", zap.String("port", strconv.Itoa(config.AppEnv.GrpcPort)))
+		if err := grpcServer.Serve(listener); err != nil {
+			zap.L().Error("gRPC server failed", zap.Error(err))
+		}
+	}()
+}
+
+func StopGrpcServer() {
+	if grpcServer == nil {
+		return
+	}
+
+	grpcServer.GracefulStop()
+	zap.L().Info("gRPC server shutdown complete")
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/MRegterschot/GbxConnector/middleware"
+	"github.com/MRegterschot/GbxConnector/structs"
 	"go.uber.org/zap"
 )
 
@@ -18,6 +20,7 @@ func loggingMiddleware(next http.Handler) http.Handler {
 			zap.String("method", r.Method),
 			zap.String("url", r.URL.String()),
 			zap.String("user_agent", r.UserAgent()),
+			zap.String("request_id", middleware.GetRequestId(r)),
 		)
 
 		// Call the next handler
@@ -29,6 +32,7 @@ func loggingMiddleware(next http.Handler) http.Handler {
 			zap.String("method", r.Method),
 			zap.String("url", r.URL.String()),
 			zap.Duration("latency", time.Since(start)),
+			zap.String("request_id", middleware.GetRequestId(r)),
 		)
 	})
 }
@@ -37,12 +41,12 @@ func loggingMiddleware(next http.Handler) http.Handler {
 func recoveryMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
-			if r := recover(); r != nil {
+			if err := recover(); err != nil {
 				// Log the panic error
-				zap.L().Error("Recovered from panic", zap.Any("error", r))
+				zap.L().Error("Recovered from panic", zap.Any("error", err), zap.String("request_id", middleware.GetRequestId(r)))
 
 				// Respond with a generic internal server error message
-				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				middleware.WriteError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Internal server error", nil)
 			}
 		}()
 		next.ServeHTTP(w, r)
@@ -54,7 +58,8 @@ func corsMiddleware(next http.Handler) http.Handler {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Last-Event-ID, X-Request-ID")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
 
 		// Preflight request
 		if r.Method == "OPTIONS" {
@@ -0,0 +1,258 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	operationJSON      = ""
+	operationWebSocket = "websocket"
+	operationSSE       = "sse"
+)
+
+// Describes a route of the router for the OpenAPI document
+type apiOperation struct {
+	Method   string
+	Path     string // As registered on the router
+	Summary  string
+	Tag      string
+	Kind     string   // JSON, websocket or SSE
+	Request  any      // Zero value of the request body, nil without a body
+	Response any      // Zero value of the response body or websocket messages, nil without a body
+	Query    []string // Query parameters
+	Public   bool     // Doesn't require an admin token
+}
+
+const uuidPath = "{uuid:[0-9a-fA-F-]{36}}"
+
+// Every route of SetupRoutes, routes without an operation are logged at startup and fail the tests
+var apiOperations = []apiOperation{
+	{Method: "GET", Path: "/health", Summary: "Health check", Tag: "general", Public: true},
+	{Method: "GET", Path: "/openapi.json", Summary: "OpenAPI document", Tag: "general", Public: true},
+	{Method: "GET", Path: "/docs", Summary: "API documentation", Tag: "general", Public: true},
+	{Method: "POST", Path: "/auth", Summary: "Create a token for a user", Tag: "auth", Request: structs.User{}, Response: map[string]string{}, Public: true},
+
+	{Method: "GET", Path: "/servers", Summary: "List the servers", Tag: "servers", Response: []structs.ServerResponse{}},
+	{Method: "POST", Path: "/servers", Summary: "Add a server", Tag: "servers", Request: structs.Server{}, Response: structs.ServerResponse{}},
+	{Method: "POST", Path: "/servers/test", Summary: "Test the connection to a server without saving it", Tag: "servers", Request: structs.Server{}, Response: structs.ServerTestResult{}},
+	{Method: "GET", Path: "/servers/export", Summary: "Export the server configurations", Tag: "servers", Response: []structs.Server{}, Query: []string{"redact"}},
+	{Method: "POST", Path: "/servers/import", Summary: "Import server configurations, merging with or replacing the existing servers", Tag: "servers", Request: []structs.ServerImport{}, Response: structs.ServerImportResult{}, Query: []string{"mode"}},
+	{Method: "PUT", Path: "/servers/" + uuidPath, Summary: "Update a server", Tag: "servers", Request: structs.Server{}, Response: structs.ServerResponse{}},
+	{Method: "DELETE", Path: "/servers/" + uuidPath, Summary: "Delete a server", Tag: "servers"},
+	{Method: "PUT", Path: "/servers/" + uuidPath + "/slots", Summary: "Set the player and spectator slots", Tag: "servers", Request: structs.SlotsRequest{}},
+	{Method: "PUT", Path: "/servers/" + uuidPath + "/players/{login}/mode", Summary: "Force a player to play or spectate", Tag: "players", Request: structs.PlayerModeRequest{}},
+	{Method: "PUT", Path: "/servers/" + uuidPath + "/players/{login}/target", Summary: "Set the spectator target of a player", Tag: "players", Request: structs.SpectatorTargetRequest{}},
+	{Method: "GET", Path: "/players/{login}", Summary: "Sessions and playtime of a player", Tag: "players", Response: structs.PlayerHistory{}},
+
+	{Method: "GET", Path: "/webhooks", Summary: "List the webhooks", Tag: "webhooks", Response: []structs.WebhookResponse{}},
+	{Method: "POST", Path: "/webhooks", Summary: "Add a webhook", Tag: "webhooks", Request: structs.Webhook{}, Response: structs.WebhookResponse{}},
+	{Method: "GET", Path: "/webhooks/deadletters", Summary: "Deliveries that failed after all retries", Tag: "webhooks", Response: []structs.DeadLetter{}},
+	{Method: "PUT", Path: "/webhooks/{id:[0-9a-fA-F-]{36}}", Summary: "Update a webhook", Tag: "webhooks", Request: structs.Webhook{}, Response: structs.WebhookResponse{}},
+	{Method: "DELETE", Path: "/webhooks/{id:[0-9a-fA-F-]{36}}", Summary: "Delete a webhook", Tag: "webhooks"},
+
+	{Method: "GET", Path: "/chat/bridges", Summary: "List the chat bridges", Tag: "chat", Response: []structs.BridgeGroup{}},
+	{Method: "PUT", Path: "/chat/bridges", Summary: "Replace the chat bridges", Tag: "chat", Request: []structs.BridgeGroup{}, Response: []structs.BridgeGroup{}},
+	{Method: "GET", Path: "/chat/" + uuidPath + "/config", Summary: "Get the chat config", Tag: "chat", Response: structs.ChatConfig{}},
+	{Method: "PUT", Path: "/chat/" + uuidPath + "/config", Summary: "Update the chat config", Tag: "chat", Request: structs.ChatConfig{}, Response: structs.ChatConfig{}},
+	{Method: "POST", Path: "/chat/" + uuidPath + "/messages", Summary: "Send a server message", Tag: "chat", Request: structs.SendChatMessageRequest{}},
+	{Method: "GET", Path: "/chat/" + uuidPath + "/languages", Summary: "Language of every active player", Tag: "chat", Response: map[string]string{}},
+	{Method: "PUT", Path: "/chat/" + uuidPath + "/languages/{login}", Summary: "Set the language of a player", Tag: "chat", Request: struct {
+		Language string `json:"language"`
+	}{}},
+	{Method: "DELETE", Path: "/chat/" + uuidPath + "/languages/{login}", Summary: "Remove the language set for a player", Tag: "chat"},
+	{Method: "GET", Path: "/chat/" + uuidPath + "/mutes", Summary: "List the muted players", Tag: "moderation", Response: []structs.Mute{}},
+	{Method: "POST", Path: "/chat/" + uuidPath + "/mutes", Summary: "Mute a player", Tag: "moderation", Request: handlers.MuteRequest{}, Response: structs.Mute{}},
+	{Method: "DELETE", Path: "/chat/" + uuidPath + "/mutes/{login}", Summary: "Unmute a player", Tag: "moderation"},
+	{Method: "GET", Path: "/chat/" + uuidPath + "/moderation/log", Summary: "Moderation log", Tag: "moderation", Response: []structs.ModerationLogEntry{}, Query: []string{"login", "action"}},
+	{Method: "POST", Path: "/script/" + uuidPath + "/trigger", Summary: "Trigger a mode script event and wait for the response", Tag: "script", Request: structs.ScriptTriggerRequest{}, Response: structs.ScriptTriggerResponse{}},
+
+	{Method: "GET", Path: "/ws/servers", Summary: "Server list updates", Tag: "streams", Kind: operationWebSocket, Response: []structs.ServerResponse{}, Query: []string{"serverUuid"}},
+	{Method: "GET", Path: "/ws/map/" + uuidPath, Summary: "Map events", Tag: "streams", Kind: operationWebSocket, Response: map[string]string{}},
+	{Method: "GET", Path: "/ws/players/" + uuidPath, Summary: "Player events", Tag: "streams", Kind: operationWebSocket, Response: map[string]any{}},
+	{Method: "GET", Path: "/ws/live/" + uuidPath, Summary: "Live events", Tag: "streams", Kind: operationWebSocket, Response: map[string]any{}},
+	{Method: "GET", Path: "/ws/chat/" + uuidPath, Summary: "Chat messages", Tag: "streams", Kind: operationWebSocket, Response: map[string]any{}},
+	{Method: "GET", Path: "/ws/script/" + uuidPath, Summary: "Script callbacks", Tag: "streams", Kind: operationWebSocket, Response: map[string]any{}},
+	{Method: "GET", Path: "/ws/events/" + uuidPath, Summary: "All events of a server as typed events", Tag: "streams", Kind: operationWebSocket, Response: structs.Event{}},
+
+	{Method: "GET", Path: "/sse/servers", Summary: "Server list updates", Tag: "streams", Kind: operationSSE, Query: []string{"serverUuid", "lastEventId"}},
+	{Method: "GET", Path: "/sse/map/" + uuidPath, Summary: "Map events", Tag: "streams", Kind: operationSSE, Query: []string{"lastEventId"}},
+	{Method: "GET", Path: "/sse/players/" + uuidPath, Summary: "Player events", Tag: "streams", Kind: operationSSE, Query: []string{"lastEventId"}},
+	{Method: "GET", Path: "/sse/live/" + uuidPath, Summary: "Live events", Tag: "streams", Kind: operationSSE, Query: []string{"lastEventId"}},
+}
+
+var pathVariable = regexp.MustCompile(`\{([^}:]+)(:[^}]*\}?)?\}`)
+
+// Converts a router path to an OpenAPI path, e.g. /servers/{uuid:[0-9a-fA-F-]{36}} to /servers/{uuid}
+func openAPIPath(path string) string {
+	return pathVariable.ReplaceAllString(path, "{$1}")
+}
+
+// Builds the OpenAPI document from the operations and the struct definitions
+func buildOpenAPI() map[string]any {
+	g := lib.NewSchemaGenerator("#/components/schemas/")
+	paths := make(map[string]any)
+
+	for _, op := range apiOperations {
+		path := openAPIPath(op.Path)
+		if _, ok := paths[path]; !ok {
+			paths[path] = make(map[string]any)
+		}
+
+		parameters := []any{}
+		for _, match := range pathVariable.FindAllStringSubmatch(op.Path, -1) {
+			schema := map[string]any{"type": "string"}
+			if match[1] == "uuid" || match[1] == "id" {
+				schema["format"] = "uuid"
+			}
+			parameters = append(parameters, map[string]any{"name": match[1], "in": "path", "required": true, "schema": schema})
+		}
+		for _, name := range op.Query {
+			parameters = append(parameters, map[string]any{"name": name, "in": "query", "schema": map[string]any{"type": "string"}})
+		}
+
+		operation := map[string]any{
+			"summary":    op.Summary,
+			"tags":       []string{op.Tag},
+			"parameters": parameters,
+			"responses":  openAPIResponses(g, op),
+		}
+
+		if op.Request != nil {
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": g.Schema(reflect.TypeOf(op.Request))},
+				},
+			}
+		}
+
+		if op.Public {
+			operation["security"] = []any{}
+		}
+
+		paths[path].(map[string]any)[strings.ToLower(op.Method)] = operation
+	}
+
+	return map[string]any{
+		"openapi": "3.1.0",
+		"info": map[string]any{
+			"title":       "GbxConnector",
+			"description": "Requests from localhost or the Docker network don't need a token.",
+			"version":     "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": g.Defs,
+			"securitySchemes": map[string]any{
+				"bearerAuth": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+		"security": []any{map[string]any{"bearerAuth": []string{}}},
+	}
+}
+
+func openAPIResponses(g *lib.SchemaGenerator, op apiOperation) map[string]any {
+	errorResponse := map[string]any{
+		"description": "Error",
+		"content": map[string]any{
+			"application/json": map[string]any{"schema": g.Schema(reflect.TypeOf(structs.ErrorResponse{}))},
+		},
+	}
+
+	switch op.Kind {
+	case operationWebSocket:
+		response := map[string]any{"description": "WebSocket connection"}
+		if op.Response != nil {
+			response["x-websocket-message"] = g.Schema(reflect.TypeOf(op.Response))
+		}
+		return map[string]any{"101": response, "default": errorResponse}
+	case operationSSE:
+		return map[string]any{
+			"200": map[string]any{
+				"description": "Server-sent events, with the same event names as the websocket",
+				"content": map[string]any{
+					"text/event-stream": map[string]any{"schema": map[string]any{"type": "string"}},
+				},
+			},
+			"default": errorResponse,
+		}
+	}
+
+	response := map[string]any{"description": "OK"}
+	if op.Response != nil {
+		response["content"] = map[string]any{
+			"application/json": map[string]any{"schema": g.Schema(reflect.TypeOf(op.Response))},
+		}
+	}
+	return map[string]any{"200": response, "default": errorResponse}
+}
+
+// Returns the routes that are missing from the OpenAPI document and the operations without a route, as "METHOD path"
+func openAPIMismatches(router *mux.Router) ([]string, []string) {
+	documented := make(map[string]bool)
+	for _, op := range apiOperations {
+		documented[op.Method+" "+op.Path] = true
+	}
+
+	undocumented := []string{}
+	routes := make(map[string]bool)
+	router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			methods = []string{http.MethodGet}
+		}
+
+		for _, method := range methods {
+			routes[method+" "+path] = true
+			if !documented[method+" "+path] {
+				undocumented = append(undocumented, method+" "+path)
+			}
+		}
+		return nil
+	})
+
+	unrouted := []string{}
+	for _, op := range apiOperations {
+		if !routes[op.Method+" "+op.Path] {
+			unrouted = append(unrouted, op.Method+" "+op.Path)
+		}
+	}
+	return undocumented, unrouted
+}
+
+// Logs the routes that are missing from the OpenAPI document and the operations without a route
+func checkOpenAPIRoutes(router *mux.Router) {
+	undocumented, unrouted := openAPIMismatches(router)
+	for _, route := range undocumented {
+		zap.L().Warn("Route missing from the OpenAPI document", zap.String("route", route))
+	}
+	for _, operation := range unrouted {
+		zap.L().Warn("OpenAPI operation without a route", zap.String("operation", operation))
+	}
+}
+
+func setupOpenAPI(router *mux.Router) {
+	checkOpenAPIRoutes(router)
+
+	spec, err := json.Marshal(buildOpenAPI())
+	if err != nil {
+		zap.L().Error("Failed to encode OpenAPI document", zap.Error(err))
+		return
+	}
+	handlers.SetOpenAPISpec(spec)
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+func TestOpenAPIOperationsMatchRoutes(t *testing.T) {
+	router := mux.NewRouter()
+	SetupRoutes(router)
+
+	undocumented, unrouted := openAPIMismatches(router)
+	for _, route := range undocumented {
+		t.Errorf("Route %s is missing from apiOperations", route)
+	}
+	for _, operation := range unrouted {
+		t.Errorf("Operation %s has no route", operation)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	doc := buildOpenAPI()
+
+	paths, ok := doc["paths"].(map[string]any)
+	if !ok {
+		t.Fatalf("paths = %T, want map[string]any", doc["paths"])
+	}
+
+	operations := 0
+	for _, item := range paths {
+		operations += len(item.(map[string]any))
+	}
+	if operations != len(apiOperations) {
+		t.Errorf("document has %d operations, want %d", operations, len(apiOperations))
+	}
+}
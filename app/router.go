@@ -9,12 +9,19 @@ import (
 )
 
 func SetupRoutes(r *mux.Router) {
+	r.NotFoundHandler = http.HandlerFunc(handlers.HandleNotFound)
+	r.MethodNotAllowedHandler = http.HandlerFunc(handlers.HandleMethodNotAllowed)
+
 	// Health check
 	r.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	}).Methods("GET")
 
+	// API documentation
+	r.HandleFunc("/openapi.json", handlers.HandleOpenAPI).Methods("GET")
+	r.HandleFunc("/docs", handlers.HandleDocs).Methods("GET")
+
 	adminOnly := middleware.RequireRoles(true)
 
 	r.HandleFunc("/auth", handlers.HandleAuth).Methods("POST")
@@ -22,12 +29,43 @@ func SetupRoutes(r *mux.Router) {
 	r.Handle("/ws/servers", adminOnly(http.HandlerFunc(handlers.HandleServersConnection))).Methods("GET")
 	r.Handle("/servers", adminOnly(http.HandlerFunc(handlers.HandleGetServers))).Methods("GET")
 	r.Handle("/servers", adminOnly(http.HandlerFunc(handlers.HandleAddServer))).Methods("POST")
+	r.Handle("/servers/test", adminOnly(http.HandlerFunc(handlers.HandleTestServer))).Methods("POST")
+	r.Handle("/servers/export", adminOnly(http.HandlerFunc(handlers.HandleExportServers))).Methods("GET")
+	r.Handle("/servers/import", adminOnly(http.HandlerFunc(handlers.HandleImportServers))).Methods("POST")
 	r.Handle("/servers/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleDeleteServer))).Methods("DELETE")
 	r.Handle("/servers/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleUpdateServer))).Methods("PUT")
+	r.Handle("/servers/{uuid:[0-9a-fA-F-]{36}}/slots", adminOnly(http.HandlerFunc(handlers.HandleSetSlots))).Methods("PUT")
+	r.Handle("/servers/{uuid:[0-9a-fA-F-]{36}}/players/{login}/mode", adminOnly(http.HandlerFunc(handlers.HandleSetPlayerMode))).Methods("PUT")
+	r.Handle("/servers/{uuid:[0-9a-fA-F-]{36}}/players/{login}/target", adminOnly(http.HandlerFunc(handlers.HandleSetSpectatorTarget))).Methods("PUT")
+	r.Handle("/players/{login}", adminOnly(http.HandlerFunc(handlers.HandleGetPlayer))).Methods("GET")
+	r.Handle("/webhooks", adminOnly(http.HandlerFunc(handlers.HandleGetWebhooks))).Methods("GET")
+	r.Handle("/webhooks", adminOnly(http.HandlerFunc(handlers.HandleAddWebhook))).Methods("POST")
+	r.Handle("/webhooks/deadletters", adminOnly(http.HandlerFunc(handlers.HandleGetDeadLetters))).Methods("GET")
+	r.Handle("/webhooks/{id:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleUpdateWebhook))).Methods("PUT")
+	r.Handle("/webhooks/{id:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleDeleteWebhook))).Methods("DELETE")
+	r.Handle("/chat/bridges", adminOnly(http.HandlerFunc(handlers.HandleGetBridges))).Methods("GET")
+	r.Handle("/chat/bridges", adminOnly(http.HandlerFunc(handlers.HandleUpdateBridges))).Methods("PUT")
 	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/config", adminOnly(http.HandlerFunc(handlers.HandleGetChatConfig))).Methods("GET")
 	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/config", adminOnly(http.HandlerFunc(handlers.HandleUpdateChatConfig))).Methods("PUT")
+	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/messages", adminOnly(http.HandlerFunc(handlers.HandleSendChatMessage))).Methods("POST")
+	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/languages", adminOnly(http.HandlerFunc(handlers.HandleGetPlayerLanguages))).Methods("GET")
+	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/languages/{login}", adminOnly(http.HandlerFunc(handlers.HandleSetPlayerLanguage))).Methods("PUT")
+	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/languages/{login}", adminOnly(http.HandlerFunc(handlers.HandleDeletePlayerLanguage))).Methods("DELETE")
+	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/mutes", adminOnly(http.HandlerFunc(handlers.HandleGetMutes))).Methods("GET")
+	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/mutes", adminOnly(http.HandlerFunc(handlers.HandleAddMute))).Methods("POST")
+	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/mutes/{login}", adminOnly(http.HandlerFunc(handlers.HandleDeleteMute))).Methods("DELETE")
+	r.Handle("/chat/{uuid:[0-9a-fA-F-]{36}}/moderation/log", adminOnly(http.HandlerFunc(handlers.HandleGetModerationLog))).Methods("GET")
+	r.Handle("/script/{uuid:[0-9a-fA-F-]{36}}/trigger", adminOnly(http.HandlerFunc(handlers.HandleTriggerScriptEvent))).Methods("POST")
 
 	r.Handle("/ws/map/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleMapConnection))).Methods("GET")
 	r.Handle("/ws/players/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandlePlayersConnection))).Methods("GET")
 	r.Handle("/ws/live/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleLiveConnection))).Methods("GET")
+	r.Handle("/ws/chat/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleChatConnection))).Methods("GET")
+	r.Handle("/ws/script/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleScriptConnection))).Methods("GET")
+	r.Handle("/ws/events/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleEventsConnection))).Methods("GET")
+
+	r.Handle("/sse/servers", adminOnly(http.HandlerFunc(handlers.HandleServersSSE))).Methods("GET")
+	r.Handle("/sse/map/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleMapSSE))).Methods("GET")
+	r.Handle("/sse/players/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandlePlayersSSE))).Methods("GET")
+	r.Handle("/sse/live/{uuid:[0-9a-fA-F-]{36}}", adminOnly(http.HandlerFunc(handlers.HandleLiveSSE))).Methods("GET")
 }
@@ -2,13 +2,13 @@ package app
 
 import (
 	"context"
-	"errors"
-
+	"fmt"
 	"slices"
 
 	"github.com/MRegterschot/GbxConnector/config"
 	"github.com/MRegterschot/GbxConnector/handlers"
 	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/listeners"
 	"github.com/MRegterschot/GbxConnector/structs"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -20,9 +20,11 @@ func ShutdownServers(servers []*structs.Server) {
 			zap.L().Info("Shutting down server", zap.String("host", server.Host), zap.Int("port", server.XMLRPCPort))
 			server.CancelFunc()
 		}
+		listeners.EndSessions(server)
 	}
 }
 
+// Stops the reconnect loop of the server. The sessions stay open, a restarted server keeps its players.
 func ShutdownServer(server *structs.Server) {
 	if server.CancelFunc != nil {
 		zap.L().Info("Shutting down server", zap.String("host", server.Host), zap.Int("port", server.XMLRPCPort))
@@ -32,87 +34,193 @@ func ShutdownServer(server *structs.Server) {
 
 // AddServer adds a new server to the configuration and sets it up
 func AddServer(server *structs.Server) (*structs.Server, error) {
-	for _, s := range config.AppEnv.Servers {
-		if s.Host == server.Host && s.XMLRPCPort == server.XMLRPCPort {
-			zap.L().Error("Server already exists", zap.String("host", server.Host), zap.Int("port", server.XMLRPCPort))
-			return nil, errors.New("server already exists")
+	server.Uuid = uuid.NewString()
+	if err := applyServerChanges(serverChanges{added: []*structs.Server{server}}); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// Changes to the server list that are saved and applied together
+type serverChanges struct {
+	added   []*structs.Server
+	updated map[*structs.Server]*structs.Server // Existing server to its new configuration
+	removed []*structs.Server
+}
+
+// Saves the changed server list and sets up, restarts and removes the servers. servers.json is written
+// before any server is changed, so nothing changes when the list conflicts or can't be saved.
+func applyServerChanges(changes serverChanges) error {
+	list := structs.ServerList{}
+	saved := structs.ServerList{}
+	for _, server := range config.AppEnv.Servers {
+		if slices.Contains(changes.removed, server) {
+			continue
+		}
+		list = append(list, server)
+		if input, ok := changes.updated[server]; ok {
+			saved = append(saved, server.UpdatedConfig(input))
+		} else {
+			saved = append(saved, server)
 		}
 	}
+	list = append(list, changes.added...)
+	saved = append(saved, changes.added...)
 
-	server.Uuid = uuid.NewString()
+	for i, server := range saved {
+		if slices.ContainsFunc(saved[:i], func(s *structs.Server) bool {
+			return s.Host == server.Host && s.XMLRPCPort == server.XMLRPCPort
+		}) {
+			zap.L().Error("Server already exists", zap.String("host", server.Host), zap.Int("port", server.XMLRPCPort))
+			return fmt.Errorf("%w: %s:%d", structs.ErrServerExists, server.Host, server.XMLRPCPort)
+		}
+	}
 
-	config.AppEnv.Servers = append(config.AppEnv.Servers, server)
-	if err := lib.WriteFile("./servers.json", &config.AppEnv.Servers); err != nil {
+	if err := lib.WriteFile("./servers.json", &saved); err != nil {
 		zap.L().Error("Failed to write servers.json", zap.Error(err))
-		return nil, err
+		return err
 	}
 
-	zap.L().Info("New server added", zap.String("server_uuid", server.Uuid))
+	config.AppEnv.Servers = list
 
+	for _, server := range changes.removed {
+		removeServer(server)
+	}
+
+	for _, server := range list {
+		input, ok := changes.updated[server]
+		if !ok {
+			continue
+		}
+		server.UpdateServer(input)
+		zap.L().Info("Server updated", zap.String("server_uuid", server.Uuid))
+		restartServer(server)
+	}
+
+	for _, server := range changes.added {
+		if server.Info == nil {
+			server.ResetLiveInfo()
+		}
+		zap.L().Info("New server added", zap.String("server_uuid", server.Uuid))
+		startServer(server)
+	}
+
+	handlers.BroadcastServers(config.AppEnv.Servers.ToServerResponses())
+	return nil
+}
+
+// Connects the client of the server and keeps it connected
+func startServer(server *structs.Server) {
 	GetClient(server)
 	handlers.GetMapSocket(server.Uuid)
 	handlers.GetPlayersSocket(server.Uuid)
+	handlers.GetChatSocket(server.Uuid)
+	handlers.GetScriptSocket(server.Uuid)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	server.Ctx = ctx
 	server.CancelFunc = cancel
 
 	go StartReconnectLoop(ctx, server)
+}
 
-	handlers.BroadcastServers(config.AppEnv.Servers.ToServerResponses())
+// ImportServers adds the imported servers. An imported server with the uuid of an existing server updates
+// that server and keeps its uuid, with replace the other existing servers are removed. The uuid of an added
+// server is kept when it is valid and not in use. servers.json is written once before any server is changed
+// or started, so a failed import leaves the configuration as it was.
+func ImportServers(servers []*structs.Server, replace bool) (structs.ServerImportResult, error) {
+	result := structs.ServerImportResult{
+		Imported: []structs.ServerResponse{},
+		Removed:  []string{},
+	}
 
-	return server, nil
-}
+	current := config.AppEnv.Servers
+	changes := serverChanges{updated: make(map[*structs.Server]*structs.Server)}
+
+	// The server that each imported server ends up as, in the order of the import
+	imported := make([]*structs.Server, len(servers))
+	for i, server := range servers {
+		if existing := current.GetByUuid(server.Uuid); existing != nil {
+			if _, ok := changes.updated[existing]; !ok {
+				changes.updated[existing] = server
+				imported[i] = existing
+				continue
+			}
+		}
 
-func DeleteServer(serverUuid string) error {
-	for i, server := range config.AppEnv.Servers {
-		if server.Uuid == serverUuid {
-			config.AppEnv.Servers = slices.Delete(config.AppEnv.Servers, i, i+1)
-			if err := lib.WriteFile("./servers.json", &config.AppEnv.Servers); err != nil {
-				zap.L().Error("Failed to write servers.json", zap.Error(err))
-				return err
+		if uuid.Validate(server.Uuid) != nil || current.GetByUuid(server.Uuid) != nil || structs.ServerList(changes.added).GetByUuid(server.Uuid) != nil {
+			server.Uuid = uuid.NewString()
+		}
+		changes.added = append(changes.added, server)
+		imported[i] = server
+	}
+
+	if replace {
+		for _, server := range current {
+			if _, ok := changes.updated[server]; !ok {
+				changes.removed = append(changes.removed, server)
 			}
-			zap.L().Info("Server deleted", zap.String("server_uuid", serverUuid))
-			handlers.BroadcastServers(config.AppEnv.Servers.ToServerResponses())
-			ShutdownServer(server)
-			return nil
 		}
 	}
-	return errors.New("server not found")
+
+	if err := applyServerChanges(changes); err != nil {
+		return result, err
+	}
+
+	for _, server := range imported {
+		result.Imported = append(result.Imported, server.ToServerResponse())
+	}
+	for _, server := range changes.removed {
+		result.Removed = append(result.Removed, server.Uuid)
+	}
+
+	zap.L().Info("Servers imported", zap.Int("imported", len(result.Imported)), zap.Int("removed", len(result.Removed)))
+	return result, nil
 }
 
-func UpdateServer(serverUuid string, serverInput *structs.Server) (*structs.Server, error) {
-	for _, server := range config.AppEnv.Servers {
-		if server.Uuid == serverUuid {
-			server.UpdateServer(
-				serverInput.Name,
-				serverInput.Description,
-				serverInput.Host,
-				serverInput.XMLRPCPort,
-				serverInput.User,
-				serverInput.Pass,
-				serverInput.FMUrl,
-			)
-
-			if err := lib.WriteFile("./servers.json", &config.AppEnv.Servers); err != nil {
-				zap.L().Error("Failed to write servers.json", zap.Error(err))
-				return nil, err
-			}
-			zap.L().Info("Server updated", zap.String("server_uuid", serverUuid))
+func DeleteServer(serverUuid string) error {
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		return structs.ErrServerNotFound
+	}
+	return applyServerChanges(serverChanges{removed: []*structs.Server{server}})
+}
 
-			ShutdownServer(server)
-			GetClient(server)
-			handlers.GetMapSocket(server.Uuid)
-			handlers.GetPlayersSocket(server.Uuid)
+// Shuts down a server that was removed from the configuration and drops its state
+func removeServer(server *structs.Server) {
+	zap.L().Info("Server deleted", zap.String("server_uuid", server.Uuid))
+	handlers.RemoveChatSocket(server.Uuid)
+	handlers.RemoveServerFromBridges(server.Uuid)
+	handlers.BroadcastServerDeleted(server.Uuid)
+	ShutdownServer(server)
+	listeners.EndSessions(server)
+}
 
-			ctx, cancel := context.WithCancel(context.Background())
-			server.Ctx = ctx
-			server.CancelFunc = cancel
-			go StartReconnectLoop(ctx, server)
+func UpdateServer(serverUuid string, serverInput *structs.Server) (*structs.Server, error) {
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		return nil, structs.ErrServerNotFound
+	}
 
-			handlers.BroadcastServers(config.AppEnv.Servers.ToServerResponses())
-			return server, nil
-		}
+	changes := serverChanges{updated: map[*structs.Server]*structs.Server{server: serverInput}}
+	if err := applyServerChanges(changes); err != nil {
+		return nil, err
 	}
-	return nil, errors.New("server not found")
+	return server, nil
+}
+
+// Restarts the server after its configuration changed
+func restartServer(server *structs.Server) {
+	ShutdownServer(server)
+	GetClient(server)
+	listeners.SyncScriptCallbacks(server)
+	handlers.GetMapSocket(server.Uuid)
+	handlers.GetPlayersSocket(server.Uuid)
+	handlers.GetChatSocket(server.Uuid)
+	handlers.GetScriptSocket(server.Uuid)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	server.Ctx = ctx
+	server.CancelFunc = cancel
+	go StartReconnectLoop(ctx, server)
 }
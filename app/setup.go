@@ -8,6 +8,8 @@ import (
 	"github.com/MRegterschot/GbxConnector/config"
 	"github.com/MRegterschot/GbxConnector/handlers"
 	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/listeners"
+	"github.com/MRegterschot/GbxConnector/middleware"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
@@ -26,6 +28,21 @@ func SetupAndRunApp() (*http.Server, error) {
 	handlers.SetAddServerFunc(AddServer)
 	handlers.SetRemoveServerFunc(DeleteServer)
 	handlers.SetUpdateServerFunc(UpdateServer)
+	handlers.SetTestServerFunc(TestConnection)
+	handlers.SetImportServersFunc(ImportServers)
+	handlers.SetScriptTriggerFunc(listeners.TriggerScriptEvent)
+	handlers.LoadDeadLetters()
+
+	// Publish the events on NATS when configured
+	if config.AppEnv.NatsUrl != "" {
+		sink, err := lib.NewNatsSink(config.AppEnv.NatsUrl, config.AppEnv.NatsSubjectPrefix)
+		if err != nil {
+			zap.L().Error("Failed to connect to NATS", zap.String("url", config.AppEnv.NatsUrl), zap.Error(err))
+		} else {
+			zap.L().Info("Publishing events to NATS", zap.String("url", config.AppEnv.NatsUrl))
+			handlers.AddEventSink(sink)
+		}
+	}
 
 	go func() {
 		zap.L().Info("Found servers", zap.Int("count", len(config.AppEnv.Servers)))
@@ -38,6 +55,8 @@ func SetupAndRunApp() (*http.Server, error) {
 			handlers.GetMapSocket(server.Uuid)
 			handlers.GetPlayersSocket(server.Uuid)
 			handlers.GetLiveSocket(server.Uuid)
+			handlers.GetChatSocket(server.Uuid)
+			handlers.GetScriptSocket(server.Uuid)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			server.Ctx = ctx
@@ -52,14 +71,17 @@ func SetupAndRunApp() (*http.Server, error) {
 		}
 	}()
 
+	StartGrpcServer()
+
 	// Create a new Gorilla Mux router
 	router := mux.NewRouter()
 
 	// Set up routes
 	SetupRoutes(router)
+	setupOpenAPI(router)
 
 	// Attach middleware
-	handler := loggingMiddleware(recoveryMiddleware(corsMiddleware(router)))
+	handler := middleware.RequestId(loggingMiddleware(recoveryMiddleware(corsMiddleware(router))))
 
 	srv := &http.Server{
 		Addr:    ":" + strconv.Itoa(config.AppEnv.Port),
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"reflect"
+
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+func jsonSchema() map[string]any {
+	g := lib.NewSchemaGenerator("#/$defs/")
+
+	events := []any{}
+	for _, definition := range structs.EventDefinitions {
+		name := eventName(definition.Topic, definition.Type)
+		g.Defs[name] = eventSchema(g, definition)
+		events = append(events, map[string]any{"$ref": "#/$defs/" + name})
+	}
+
+	return map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "Event",
+		"oneOf":   events,
+		"$defs":   g.Defs,
+	}
+}
+
+// Schema of the Event envelope with the topic, type and payload of the definition
+func eventSchema(g *lib.SchemaGenerator, definition structs.EventDefinition) map[string]any {
+	properties := make(map[string]any)
+	required := []string{}
+
+	for _, f := range lib.JSONFields(reflect.TypeOf(structs.Event{})) {
+		switch f.Name {
+		case "topic":
+			properties[f.Name] = map[string]any{"const": definition.Topic}
+		case "type":
+			if definition.Type != "" {
+				properties[f.Name] = map[string]any{"const": definition.Type}
+			} else {
+				properties[f.Name] = map[string]any{"type": "string"}
+			}
+		case "payload":
+			t := reflect.TypeOf(definition.Payload)
+			properties[f.Name] = g.Nullable(g.Schema(t), lib.IsNullable(t))
+		default:
+			properties[f.Name] = g.Schema(f.Type)
+		}
+		required = append(required, f.Name)
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
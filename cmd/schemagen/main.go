@@ -0,0 +1,47 @@
+// Command schemagen generates the JSON Schema and TypeScript definitions of the events from structs.EventDefinitions.
+//
+//	go run ./cmd/schemagen -out schema
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	out := flag.String("out", "schema", "Output directory")
+	flag.Parse()
+
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+
+	schema, err := json.MarshalIndent(jsonSchema(), "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to encode JSON schema: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(*out, "events.schema.json"), append(schema, '\n'), 0644); err != nil {
+		log.Fatalf("Failed to write JSON schema: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(*out, "events.ts"), []byte(typeScript()), 0644); err != nil {
+		log.Fatalf("Failed to write TypeScript definitions: %v", err)
+	}
+}
+
+// Converts an event topic and type to a type name, e.g. live and beginMatch to LiveBeginMatchEvent
+func eventName(topic string, eventType string) string {
+	return upperFirst(topic) + upperFirst(eventType) + "Event"
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+// Topics of the broadcast functions that take a map of event names to payloads
+var broadcastTopics = map[string]string{
+	"BroadcastLive":    "live",
+	"BroadcastMap":     "map",
+	"BroadcastPlayers": "players",
+}
+
+func TestSchemaUpToDate(t *testing.T) {
+	schema, err := json.MarshalIndent(jsonSchema(), "", "  ")
+	if err != nil {
+		t.Fatalf("Failed to encode JSON schema: %v", err)
+	}
+
+	files := map[string][]byte{
+		"events.schema.json": append(schema, '\n'),
+		"events.ts":          []byte(typeScript()),
+	}
+
+	for name, generated := range files {
+		existing, err := os.ReadFile(filepath.Join("..", "..", "schema", name))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", name, err)
+		}
+		if !bytes.Equal(existing, generated) {
+			t.Errorf("schema/%s is out of date, run go generate ./structs", name)
+		}
+	}
+}
+
+func TestBroadcastEventsDefined(t *testing.T) {
+	defined := make(map[string]bool)
+	for _, definition := range structs.EventDefinitions {
+		defined[definition.Topic+"."+definition.Type] = true
+	}
+
+	events := make(map[string]token.Position)
+	for _, dir := range []string{"listeners", "handlers", "app"} {
+		for key, pos := range broadcastEvents(t, filepath.Join("..", "..", dir)) {
+			events[key] = pos
+		}
+	}
+
+	if len(events) == 0 {
+		t.Fatal("No broadcast events found")
+	}
+
+	for key, pos := range events {
+		if !defined[key] {
+			t.Errorf("%s: event %s is broadcast but missing from structs.EventDefinitions", pos, key)
+		}
+	}
+}
+
+// Returns the topic.event pairs that are broadcast in the package directory, with the position of the call
+func broadcastEvents(t *testing.T, dir string) map[string]token.Position {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := make(map[string]token.Position)
+	for _, path := range paths {
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v", path, err)
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			switch name := funcName(call); {
+			case broadcastTopics[name] != "" && len(call.Args) == 2:
+				literal, ok := call.Args[1].(*ast.CompositeLit)
+				if !ok {
+					return true
+				}
+				for _, elt := range literal.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					if event, ok := stringLiteral(kv.Key); ok {
+						events[broadcastTopics[name]+"."+event] = fset.Position(kv.Pos())
+					} else {
+						t.Errorf("%s: event name is not a string literal", fset.Position(kv.Pos()))
+					}
+				}
+			case name == "publishEvent" && len(call.Args) == 4:
+				topic, topicOk := stringLiteral(call.Args[1])
+				event, eventOk := stringLiteral(call.Args[2])
+				if topicOk && eventOk {
+					events[topic+"."+event] = fset.Position(call.Pos())
+				}
+			}
+			return true
+		})
+	}
+	return events
+}
+
+func funcName(call *ast.CallExpr) string {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return fn.Name
+	case *ast.SelectorExpr:
+		return fn.Sel.Name
+	}
+	return ""
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(literal.Value)
+	return value, err == nil
+}
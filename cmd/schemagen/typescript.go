@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+// Go type names that would shadow TypeScript globals get a prefix
+var reservedTypeScriptNames = map[string]bool{
+	"Array":   true,
+	"Date":    true,
+	"Error":   true,
+	"Event":   true,
+	"Map":     true,
+	"Object":  true,
+	"Promise": true,
+	"Record":  true,
+	"Set":     true,
+}
+
+func typeScriptName(t reflect.Type) string {
+	if reservedTypeScriptNames[t.Name()] {
+		return "Gbx" + t.Name()
+	}
+	return t.Name()
+}
+
+type typeScriptGenerator struct {
+	seen  map[reflect.Type]bool
+	order []reflect.Type // Interfaces in the order they are found
+}
+
+func typeScript() string {
+	g := &typeScriptGenerator{seen: make(map[reflect.Type]bool)}
+
+	var events strings.Builder
+	names := []string{}
+	for _, definition := range structs.EventDefinitions {
+		name := eventName(definition.Topic, definition.Type)
+		names = append(names, name)
+
+		eventType := "string"
+		if definition.Type != "" {
+			eventType = strconv.Quote(definition.Type)
+		}
+
+		t := reflect.TypeOf(definition.Payload)
+		fmt.Fprintf(&events, "export type %s = Event<%s, %s, %s>;\n", name, strconv.Quote(definition.Topic), eventType, g.nullable(g.typeName(t), lib.IsNullable(t)))
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by schemagen. DO NOT EDIT.\n\n")
+
+	// The envelope, generic over the topic, type and payload
+	b.WriteString("export interface Event<Topic extends string = string, Type extends string = string, Payload = unknown> {\n")
+	for _, f := range lib.JSONFields(reflect.TypeOf(structs.Event{})) {
+		fieldType := g.typeName(f.Type)
+		switch f.Name {
+		case "topic":
+			fieldType = "Topic"
+		case "type":
+			fieldType = "Type"
+		case "payload":
+			fieldType = "Payload"
+		}
+		fmt.Fprintf(&b, "  %s: %s;\n", f.Name, fieldType)
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString(events.String())
+	b.WriteString("\nexport type AnyEvent =\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "  | %s\n", name)
+	}
+	b.WriteString(";\n")
+
+	// Interfaces can add more interfaces while they are written
+	for i := 0; i < len(g.order); i++ {
+		t := g.order[i]
+		fmt.Fprintf(&b, "\nexport interface %s %s\n", typeScriptName(t), g.object(t, ""))
+	}
+
+	return b.String()
+}
+
+func (g *typeScriptGenerator) nullable(typeName string, nullable bool) string {
+	if !nullable {
+		return typeName
+	}
+	return typeName + " | null"
+}
+
+func (g *typeScriptGenerator) typeName(t reflect.Type) string {
+	if t == lib.TimeType {
+		return "string"
+	}
+	if t == lib.RawMessageType {
+		return "unknown"
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.typeName(t.Elem())
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		elem := g.typeName(t.Elem())
+		if strings.Contains(elem, " ") {
+			elem = "(" + elem + ")"
+		}
+		return elem + "[]"
+	case reflect.Map:
+		return "Record<string, " + g.typeName(t.Elem()) + ">"
+	case reflect.Struct:
+		if lib.IsSchemaDefinition(t) {
+			if !g.seen[t] {
+				g.seen[t] = true
+				g.order = append(g.order, t)
+			}
+			return typeScriptName(t)
+		}
+		return g.object(t, "  ")
+	}
+
+	return "unknown"
+}
+
+func (g *typeScriptGenerator) object(t reflect.Type, indent string) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, f := range lib.JSONFields(t) {
+		optional := ""
+		if f.OmitEmpty {
+			optional = "?"
+		}
+		fmt.Fprintf(&b, "%s  %s%s: %s;\n", indent, f.Name, optional, g.nullable(g.typeName(f.Type), !f.OmitEmpty && lib.IsNullable(f.Type)))
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
@@ -31,6 +31,47 @@ func LoadEnv() error {
 		server.ResetLiveInfo()
 	}
 
+	// Restore the mutes and audit trail of each server
+	moderation := make(map[string]structs.ModerationState)
+	if err = lib.ReadFile("./moderation.json", &moderation); err != nil {
+		if err = lib.CreateIfNotExists("./moderation.json"); err != nil {
+			return err
+		}
+	}
+
+	for _, server := range servers {
+		if state, ok := moderation[server.Uuid]; ok {
+			server.Info.Moderation.Restore(state)
+		}
+	}
+
+	bridges := make([]*structs.BridgeGroup, 0)
+	if err = lib.ReadFile("./bridges.json", &bridges); err != nil {
+		if err = lib.CreateIfNotExists("./bridges.json"); err != nil {
+			return err
+		}
+	}
+
+	webhooks := make([]*structs.Webhook, 0)
+	if err = lib.ReadFile("./webhooks.json", &webhooks); err != nil {
+		if err = lib.CreateIfNotExists("./webhooks.json"); err != nil {
+			return err
+		}
+	}
+
+	sessions := make([]structs.PlayerSession, 0)
+	if err = lib.ReadFile("./sessions.json", &sessions); err != nil {
+		if err = lib.CreateIfNotExists("./sessions.json"); err != nil {
+			return err
+		}
+	}
+
+	// The gRPC server is only started when a port is set
+	grpcPort, err := strconv.Atoi(os.Getenv("GRPC_PORT"))
+	if err != nil {
+		grpcPort = 0
+	}
+
 	reconnectInterval, err := strconv.Atoi(os.Getenv("SERVER_RECONNECT_INTERVAL"))
 	if err != nil {
 		reconnectInterval = 5
@@ -42,7 +83,13 @@ func LoadEnv() error {
 		JwtSecret:          os.Getenv("JWT_SECRET"),
 		ReconnectInterval:  time.Duration(reconnectInterval) * time.Second,
 		DockerNetworkRange: os.Getenv("DOCKER_NETWORK_RANGE"),
+		NatsUrl:            os.Getenv("NATS_URL"),
+		NatsSubjectPrefix:  os.Getenv("NATS_SUBJECT_PREFIX"),
+		GrpcPort:           grpcPort,
 		Servers:            servers,
+		Bridges:            bridges,
+		Webhooks:           webhooks,
+		Sessions:           structs.NewSessionStore(sessions),
 	}
 
 	return nil
@@ -14,14 +14,14 @@ func HandleAuth(w http.ResponseWriter, r *http.Request) {
 	var user structs.User
 	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
 		zap.L().Error("Failed to decode user", zap.Error(err))
-		http.Error(w, "Failed to decode user", http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode user", err)
 		return
 	}
 
 	token, err := lib.GenerateJWT(user)
 	if err != nil {
 		zap.L().Error("Failed to generate JWT token", zap.Error(err))
-		http.Error(w, "Failed to generate JWT token", http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to generate JWT token", err)
 		return
 	}
 
@@ -30,7 +30,7 @@ func HandleAuth(w http.ResponseWriter, r *http.Request) {
 	response := map[string]string{"token": token}
 	if err := json.NewEncoder(w).Encode(response); err != nil {
 		zap.L().Error("Failed to encode response", zap.Error(err))
-		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode response", err)
 		return
 	}
 }
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/middleware"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func HandleGetBridges(w http.ResponseWriter, r *http.Request) {
+	if err := json.NewEncoder(w).Encode(config.AppEnv.Bridges); err != nil {
+		zap.L().Error("Failed to encode bridges", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode bridges", err)
+	}
+}
+
+// HandleUpdateBridges replaces all bridge groups
+func HandleUpdateBridges(w http.ResponseWriter, r *http.Request) {
+	bridges := make([]*structs.BridgeGroup, 0)
+	if err := json.NewDecoder(r.Body).Decode(&bridges); err != nil {
+		zap.L().Error("Failed to decode bridges", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode bridges", err)
+		return
+	}
+
+	for _, bridge := range bridges {
+		if len(bridge.Servers) < 2 {
+			writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "A bridge group needs at least two servers", nil)
+			return
+		}
+
+		for _, serverUuid := range bridge.Servers {
+			if config.AppEnv.Servers.GetByUuid(serverUuid) == nil {
+				middleware.WriteError(w, r, http.StatusBadRequest, structs.ErrorCodeServerNotFound, "Server not found", map[string]string{"serverUuid": serverUuid})
+				return
+			}
+		}
+
+		if bridge.Id == "" {
+			bridge.Id = uuid.NewString()
+		}
+	}
+
+	if err := lib.WriteFile("./bridges.json", &bridges); err != nil {
+		zap.L().Error("Failed to write bridges.json", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to save bridges", err)
+		return
+	}
+
+	config.AppEnv.Bridges = bridges
+	zap.L().Info("Updated chat bridges", zap.Int("count", len(bridges)))
+
+	if err := json.NewEncoder(w).Encode(config.AppEnv.Bridges); err != nil {
+		zap.L().Error("Failed to encode bridges", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode bridges", err)
+	}
+}
+
+// RemoveServerFromBridges removes a deleted server from the bridge groups,
+// groups left with less than two servers are removed as well
+func RemoveServerFromBridges(serverUuid string) {
+	changed := false
+	bridges := make([]*structs.BridgeGroup, 0, len(config.AppEnv.Bridges))
+	for _, bridge := range config.AppEnv.Bridges {
+		if slices.Contains(bridge.Servers, serverUuid) {
+			changed = true
+			bridge.Servers = slices.DeleteFunc(bridge.Servers, func(s string) bool {
+				return s == serverUuid
+			})
+		}
+
+		if len(bridge.Servers) < 2 {
+			zap.L().Info("Removed chat bridge without servers to bridge", zap.String("bridge_id", bridge.Id))
+			continue
+		}
+		bridges = append(bridges, bridge)
+	}
+
+	if !changed {
+		return
+	}
+
+	config.AppEnv.Bridges = bridges
+	if err := lib.WriteFile("./bridges.json", &bridges); err != nil {
+		zap.L().Error("Failed to write bridges.json", zap.Error(err))
+	}
+}
@@ -3,13 +3,173 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/MRegterschot/GbxConnector/config"
 	"github.com/MRegterschot/GbxConnector/structs"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
 )
 
+// How long chat messages are kept to send to newly connected clients
+const chatBacklogDuration = 5 * time.Minute
+
+var chatSockets = make(map[string]*structs.SocketClients) // Map of socket clients by server ID
+
+var (
+	chatBacklogs   = make(map[string][]structs.ChatMessage) // Recent chat messages by server ID
+	chatBacklogsMu sync.Mutex
+)
+
+func GetChatSocket(serverUuid string) *structs.SocketClients {
+	if _, ok := chatSockets[serverUuid]; !ok {
+		chatSockets[serverUuid] = &structs.SocketClients{
+			Clients: make(map[*websocket.Conn]bool),
+		}
+	}
+	return chatSockets[serverUuid]
+}
+
+// Closes the chat socket and drops the backlog of a removed server
+func RemoveChatSocket(serverUuid string) {
+	if cs, ok := chatSockets[serverUuid]; ok {
+		cs.ClientsMu.Lock()
+		for conn := range cs.Clients {
+			conn.Close()
+		}
+		cs.ClientsMu.Unlock()
+		delete(chatSockets, serverUuid)
+	}
+
+	chatBacklogsMu.Lock()
+	delete(chatBacklogs, serverUuid)
+	chatBacklogsMu.Unlock()
+}
+
+// WebSocket connection handler
+func HandleChatConnection(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		zap.L().Error("Failed to upgrade connection", zap.Error(err))
+		return
+	}
+
+	// Save connection
+	cs := GetChatSocket(serverUuid)
+	cs.ClientsMu.Lock()
+	cs.Clients[conn] = true
+	cs.ClientsMu.Unlock()
+
+	// Send the recent messages to the client
+	if err := conn.WriteJSON(map[string][]structs.ChatMessage{
+		"backlog": getChatBacklog(serverUuid),
+	}); err != nil {
+		zap.L().Error("Failed to send initial message to client", zap.Error(err))
+		cs.ClientsMu.Lock()
+		delete(cs.Clients, conn)
+		cs.ClientsMu.Unlock()
+		conn.Close()
+		return
+	}
+
+	// Handle disconnection
+	go func() {
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				zap.L().Info("WebSocket connection closed", zap.String("remoteAddr", conn.RemoteAddr().String()), zap.String("server_uuid", serverUuid))
+				cs.ClientsMu.Lock()
+				delete(cs.Clients, conn)
+				cs.ClientsMu.Unlock()
+				conn.Close()
+				break
+			}
+		}
+	}()
+}
+
+// Broadcast a chat message to all connected clients and add it to the backlog
+func BroadcastChat(serverUuid string, message structs.ChatMessage) {
+	if message.Time.IsZero() {
+		message.Time = time.Now()
+	}
+
+	addChatBacklog(serverUuid, message)
+	publishEvent(serverUuid, "chat", "message", message)
+
+	cs := GetChatSocket(serverUuid)
+	if cs == nil {
+		zap.L().Error("Chat socket not found", zap.String("server_uuid", serverUuid))
+		return
+	}
+
+	cs.ClientsMu.Lock()
+	defer cs.ClientsMu.Unlock()
+
+	for conn := range cs.Clients {
+		if err := conn.WriteJSON(map[string]structs.ChatMessage{
+			"message": message,
+		}); err != nil {
+			zap.L().Error("Failed to send message to client", zap.Error(err))
+			conn.Close()
+			delete(cs.Clients, conn)
+		}
+	}
+}
+
+// Sends a server message to everyone and broadcasts it to the chat socket.
+// A message to the given logins only is private, it is not broadcast or added to the backlog.
+func SendChatMessage(server *structs.Server, message string, logins []string) error {
+	if len(logins) > 0 {
+		return server.Client.ChatSendServerMessageToLogin(message, strings.Join(logins, ","))
+	}
+
+	if err := server.Client.ChatSendServerMessage(message); err != nil {
+		return err
+	}
+
+	BroadcastChat(server.Uuid, structs.ChatMessage{
+		Type: structs.ChatMessageTypeServer,
+		Text: message,
+	})
+	return nil
+}
+
+func addChatBacklog(serverUuid string, message structs.ChatMessage) {
+	chatBacklogsMu.Lock()
+	defer chatBacklogsMu.Unlock()
+
+	chatBacklogs[serverUuid] = append(pruneChatBacklog(chatBacklogs[serverUuid]), message)
+}
+
+func getChatBacklog(serverUuid string) []structs.ChatMessage {
+	chatBacklogsMu.Lock()
+	defer chatBacklogsMu.Unlock()
+
+	backlog := pruneChatBacklog(chatBacklogs[serverUuid])
+	chatBacklogs[serverUuid] = backlog
+
+	messages := make([]structs.ChatMessage, len(backlog))
+	copy(messages, backlog)
+	return messages
+}
+
+// Drops the messages older than the backlog duration
+func pruneChatBacklog(backlog []structs.ChatMessage) []structs.ChatMessage {
+	cutoff := time.Now().Add(-chatBacklogDuration)
+	for i, message := range backlog {
+		if message.Time.After(cutoff) {
+			return backlog[i:]
+		}
+	}
+	return backlog[:0]
+}
+
 func HandleGetChatConfig(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	serverUuid := vars["uuid"]
@@ -18,14 +178,14 @@ func HandleGetChatConfig(w http.ResponseWriter, r *http.Request) {
 		if server.Uuid == serverUuid {
 			if err := json.NewEncoder(w).Encode(server.Info.Chat); err != nil {
 				zap.L().Error("Failed to encode chat config", zap.Error(err))
-				http.Error(w, "Failed to encode chat config", http.StatusInternalServerError)
+				writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode chat config", err)
 			}
 			return
 		}
 	}
 
 	zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
-	http.Error(w, "Server not found", http.StatusNotFound)
+	writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
 }
 
 func HandleUpdateChatConfig(w http.ResponseWriter, r *http.Request) {
@@ -35,7 +195,12 @@ func HandleUpdateChatConfig(w http.ResponseWriter, r *http.Request) {
 	var chatConfig structs.ChatConfig
 	if err := json.NewDecoder(r.Body).Decode(&chatConfig); err != nil {
 		zap.L().Error("Failed to decode chat config", zap.Error(err))
-		http.Error(w, "Failed to decode chat config", http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode chat config", err)
+		return
+	}
+
+	if err := chatConfig.Moderation.CompileWordFilter(); err != nil {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Invalid banned words", err)
 		return
 	}
 
@@ -43,7 +208,7 @@ func HandleUpdateChatConfig(w http.ResponseWriter, r *http.Request) {
 		if server.Uuid == serverUuid {
 			if err := server.Client.ChatEnableManualRouting(chatConfig.ManualRouting, true); err != nil {
 				zap.L().Error("Failed to set manual routing", zap.Error(err))
-				http.Error(w, "Failed to set manual routing", http.StatusInternalServerError)
+				writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to set manual routing", err)
 				return
 			}
 
@@ -51,12 +216,124 @@ func HandleUpdateChatConfig(w http.ResponseWriter, r *http.Request) {
 			zap.L().Info("Updated chat config", zap.String("server_uuid", server.Uuid), zap.Any("chat_config", chatConfig))
 			if err := json.NewEncoder(w).Encode(server.Info.Chat); err != nil {
 				zap.L().Error("Failed to encode updated chat config", zap.Error(err))
-				http.Error(w, "Failed to encode updated chat config", http.StatusInternalServerError)
+				writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode updated chat config", err)
 			}
 			return
 		}
 	}
 
 	zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
-	http.Error(w, "Server not found", http.StatusNotFound)
+	writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+}
+
+func HandleSendChatMessage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+
+	var request structs.SendChatMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		zap.L().Error("Failed to decode chat message", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode chat message", err)
+		return
+	}
+
+	if strings.TrimSpace(request.Message) == "" {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Message is required", nil)
+		return
+	}
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	if server.Client == nil || !server.Client.IsConnected {
+		writeError(w, r, http.StatusServiceUnavailable, structs.ErrorCodeServerNotConnected, "Server not connected", nil)
+		return
+	}
+
+	if err := SendChatMessage(server, request.Message, request.Logins); err != nil {
+		zap.L().Error("Failed to send chat message", zap.String("server_uuid", serverUuid), zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to send chat message", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleGetPlayerLanguages returns the language used for each active player
+func HandleGetPlayerLanguages(w http.ResponseWriter, r *http.Request) {
+	serverUuid := mux.Vars(r)["uuid"]
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	languages := make(map[string]string)
+	for _, player := range server.Info.ActivePlayers {
+		languages[player.Login] = server.PlayerLanguage(player.Login)
+	}
+
+	if err := json.NewEncoder(w).Encode(languages); err != nil {
+		zap.L().Error("Failed to encode player languages", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode player languages", err)
+	}
+}
+
+// HandleSetPlayerLanguage manually sets the language of a player, overriding the detected language
+func HandleSetPlayerLanguage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+	login := vars["login"]
+
+	var request struct {
+		Language string `json:"language"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		zap.L().Error("Failed to decode language", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode language", err)
+		return
+	}
+
+	if structs.BaseLanguage(request.Language) == "" {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Language is required", nil)
+		return
+	}
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	server.Info.Languages.SetManual(login, request.Language)
+	zap.L().Info("Set player language", zap.String("server_uuid", serverUuid), zap.String("login", login), zap.String("language", request.Language))
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleDeletePlayerLanguage removes the manually set language of a player
+func HandleDeletePlayerLanguage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+	login := vars["login"]
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	if !server.Info.Languages.ClearManual(login) {
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeNotFound, "No language set for player", nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
 }
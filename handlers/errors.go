@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/MRegterschot/GbxConnector/middleware"
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+// Writes a JSON error, the error is added to the details so clients can show the cause
+func writeError(w http.ResponseWriter, r *http.Request, status int, code structs.ErrorCode, message string, err error) {
+	var details any
+	if err != nil {
+		details = map[string]string{"error": err.Error()}
+	}
+	middleware.WriteError(w, r, status, code, message, details)
+}
+
+// Writes the error of a server operation, the sentinel errors get their own status and code
+func writeServerError(w http.ResponseWriter, r *http.Request, message string, err error) {
+	switch {
+	case errors.Is(err, structs.ErrServerNotFound):
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+	case errors.Is(err, structs.ErrServerExists):
+		writeError(w, r, http.StatusConflict, structs.ErrorCodeServerExists, "A server with this host and port already exists", err)
+	default:
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, message, err)
+	}
+}
+
+// Writes the field errors of a request body that failed validation
+func writeValidationError(w http.ResponseWriter, r *http.Request, errs []structs.FieldError) {
+	middleware.WriteError(w, r, http.StatusBadRequest, structs.ErrorCodeValidationFailed, "Validation failed", map[string][]structs.FieldError{"fields": errs})
+}
+
+func HandleNotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, http.StatusNotFound, structs.ErrorCodeNotFound, "Route not found", nil)
+}
+
+func HandleMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, http.StatusMethodNotAllowed, structs.ErrorCodeMethodNotAllowed, "Method not allowed", nil)
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+var (
+	eventsSockets   = make(map[string]*structs.SocketClients) // Map of socket clients by server ID
+	eventsSocketsMu sync.Mutex
+)
+
+var (
+	eventSeqs   = make(map[string]uint64) // Last sequence number by server ID
+	eventSeqsMu sync.Mutex
+)
+
+// Sends every event of a server to the events websocket, wrapped in an Event
+type eventsSink struct{}
+
+func init() {
+	AddEventSink(eventsSink{})
+}
+
+func (eventsSink) Publish(serverUuid string, topic string, event string, payload json.RawMessage) error {
+	es := GetEventsSocket(serverUuid)
+
+	es.ClientsMu.Lock()
+	defer es.ClientsMu.Unlock()
+
+	e := newEvent(serverUuid, topic, event, payload)
+	for conn := range es.Clients {
+		if err := conn.WriteJSON(e); err != nil {
+			zap.L().Error("Failed to send message to client", zap.Error(err))
+			conn.Close()
+			delete(es.Clients, conn)
+		}
+	}
+	return nil
+}
+
+func (eventsSink) Close() error {
+	return nil
+}
+
+func (eventsSink) Active() bool {
+	eventsSocketsMu.Lock()
+	defer eventsSocketsMu.Unlock()
+
+	for _, es := range eventsSockets {
+		es.ClientsMu.Lock()
+		clients := len(es.Clients)
+		es.ClientsMu.Unlock()
+
+		if clients > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func GetEventsSocket(serverUuid string) *structs.SocketClients {
+	eventsSocketsMu.Lock()
+	defer eventsSocketsMu.Unlock()
+
+	if _, ok := eventsSockets[serverUuid]; !ok {
+		eventsSockets[serverUuid] = &structs.SocketClients{
+			Clients: make(map[*websocket.Conn]bool),
+		}
+	}
+	return eventsSockets[serverUuid]
+}
+
+func newEvent(serverUuid string, topic string, event string, payload json.RawMessage) structs.Event {
+	eventSeqsMu.Lock()
+	eventSeqs[serverUuid]++
+	seq := eventSeqs[serverUuid]
+	eventSeqsMu.Unlock()
+
+	return structs.Event{
+		Topic:      topic,
+		Type:       event,
+		ServerUuid: serverUuid,
+		Timestamp:  time.Now(),
+		Seq:        seq,
+		Payload:    payload,
+	}
+}
+
+// WebSocket connection handler, sends the events of all topics of a server as Event
+func HandleEventsConnection(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		zap.L().Error("Failed to upgrade connection", zap.Error(err))
+		return
+	}
+
+	es := GetEventsSocket(serverUuid)
+	es.ClientsMu.Lock()
+
+	// Send the current state first, while no other events can be sent to the client
+	if err := writeEventsSnapshot(conn, serverUuid); err != nil {
+		es.ClientsMu.Unlock()
+		zap.L().Error("Failed to send initial message to client", zap.Error(err))
+		conn.Close()
+		return
+	}
+
+	// Save connection
+	es.Clients[conn] = true
+	es.ClientsMu.Unlock()
+
+	// Handle disconnection
+	go func() {
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				zap.L().Info("WebSocket connection closed", zap.String("remoteAddr", conn.RemoteAddr().String()), zap.String("server_uuid", serverUuid))
+				es.ClientsMu.Lock()
+				delete(es.Clients, conn)
+				es.ClientsMu.Unlock()
+				conn.Close()
+				break
+			}
+		}
+	}()
+}
+
+func writeEventsSnapshot(conn *websocket.Conn, serverUuid string) error {
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		return nil
+	}
+
+	activePlayers := make([]structs.PlayerInfo, 0)
+	if server.Info.ActivePlayers != nil {
+		activePlayers = server.Info.ActivePlayers
+	}
+
+	snapshot := []struct {
+		topic   string
+		event   string
+		payload any
+	}{
+		{"servers", "update", server.ToServerResponse()},
+		{"map", "activeMap", server.Info.ActiveMap},
+		{"players", "playerList", activePlayers},
+		{"live", "beginMatch", server.Info.LiveInfo},
+		{"chat", "backlog", getChatBacklog(serverUuid)},
+	}
+
+	for _, s := range snapshot {
+		payload, err := json.Marshal(s.payload)
+		if err != nil {
+			return err
+		}
+
+		if err := conn.WriteJSON(newEvent(serverUuid, s.topic, s.event, payload)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
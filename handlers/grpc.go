@@ -0,0 +1,603 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/pb"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const grpcStreamBufferSize = 64
+
+// GrpcServer implements the gRPC API, with the same behaviour as the REST handlers and websockets
+type GrpcServer struct {
+	pb.UnimplementedGbxConnectorServer
+}
+
+type grpcEvent struct {
+	serverUuid string
+	event      string
+	payload    json.RawMessage
+}
+
+type grpcSubscriber struct {
+	topic       string
+	serverUuids map[string]bool // All servers when empty
+	events      chan grpcEvent
+}
+
+var (
+	grpcSubscribers   = make(map[*grpcSubscriber]bool)
+	grpcSubscribersMu sync.Mutex
+)
+
+// Sends the events to the gRPC streams
+type grpcSink struct{}
+
+func NewGrpcServer() *GrpcServer {
+	AddEventSink(grpcSink{})
+	return &GrpcServer{}
+}
+
+func (grpcSink) Publish(serverUuid string, topic string, event string, payload json.RawMessage) error {
+	grpcSubscribersMu.Lock()
+	defer grpcSubscribersMu.Unlock()
+
+	for sub := range grpcSubscribers {
+		if sub.topic != topic || (len(sub.serverUuids) > 0 && !sub.serverUuids[serverUuid]) {
+			continue
+		}
+
+		select {
+		case sub.events <- grpcEvent{serverUuid: serverUuid, event: event, payload: payload}:
+		default:
+			// The client can't keep up, end its stream
+			close(sub.events)
+			delete(grpcSubscribers, sub)
+		}
+	}
+	return nil
+}
+
+func (grpcSink) Close() error {
+	return nil
+}
+
+func (grpcSink) Active() bool {
+	grpcSubscribersMu.Lock()
+	defer grpcSubscribersMu.Unlock()
+
+	return len(grpcSubscribers) > 0
+}
+
+func subscribeGrpc(topic string, serverUuids ...string) *grpcSubscriber {
+	sub := &grpcSubscriber{
+		topic:       topic,
+		serverUuids: make(map[string]bool),
+		events:      make(chan grpcEvent, grpcStreamBufferSize),
+	}
+	for _, uuid := range serverUuids {
+		sub.serverUuids[uuid] = true
+	}
+
+	grpcSubscribersMu.Lock()
+	grpcSubscribers[sub] = true
+	grpcSubscribersMu.Unlock()
+
+	return sub
+}
+
+func unsubscribeGrpc(sub *grpcSubscriber) {
+	grpcSubscribersMu.Lock()
+	defer grpcSubscribersMu.Unlock()
+
+	if grpcSubscribers[sub] {
+		close(sub.events)
+		delete(grpcSubscribers, sub)
+	}
+}
+
+// Sends the snapshot and then every event of the subscription until the client disconnects
+func streamGrpcEvents(ctx context.Context, sub *grpcSubscriber, snapshot func() error, send func(e grpcEvent) error) error {
+	defer unsubscribeGrpc(sub)
+
+	if err := snapshot(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "Stream can't keep up with the events")
+			}
+			if err := send(e); err != nil {
+				zap.L().Error("Failed to send event to gRPC client", zap.String("server_uuid", e.serverUuid), zap.String("event", e.event), zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+// The protobuf messages use the same field names as the JSON, so they are converted through their JSON
+func toProto(v any, m proto.Message) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return rawToProto(data, m)
+}
+
+func rawToProto(data json.RawMessage, m proto.Message) error {
+	if string(data) == "null" {
+		return nil
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, m)
+}
+
+func fromProto(m proto.Message, v any) error {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func rawToValue(data json.RawMessage) (*structpb.Value, error) {
+	value := &structpb.Value{}
+	return value, protojson.Unmarshal(data, value)
+}
+
+// Wraps a JSON payload in an object, for messages that only hold a list or map
+func wrapRaw(key string, data json.RawMessage) json.RawMessage {
+	wrapped, _ := json.Marshal(map[string]json.RawMessage{key: data})
+	return wrapped
+}
+
+func grpcServer(serverUuid string) (*structs.Server, error) {
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		return nil, status.Error(codes.NotFound, "Server not found")
+	}
+	return server, nil
+}
+
+// The gRPC status of a server operation error, like writeServerError
+func grpcServerError(message string, err error) error {
+	switch {
+	case errors.Is(err, structs.ErrServerNotFound):
+		return status.Error(codes.NotFound, "Server not found")
+	case errors.Is(err, structs.ErrServerExists):
+		return status.Error(codes.AlreadyExists, "A server with this host and port already exists")
+	}
+	return status.Error(codes.Internal, message)
+}
+
+// The gRPC status of a server that failed validation, with the field errors as bad request details
+func grpcValidationError(errs []structs.FieldError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(errs))
+	for i, fieldErr := range errs {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: fieldErr.Field, Description: fieldErr.Message}
+	}
+
+	st, err := status.New(codes.InvalidArgument, "Validation failed").WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "Validation failed")
+	}
+	return st.Err()
+}
+
+func serverResponseToProto(server structs.ServerResponse) (*pb.ServerResponse, error) {
+	response := &pb.ServerResponse{}
+	if err := toProto(server, response); err != nil {
+		zap.L().Error("Failed to encode server response", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to encode server response")
+	}
+	return response, nil
+}
+
+func (s *GrpcServer) ListServers(ctx context.Context, _ *emptypb.Empty) (*pb.ServerList, error) {
+	list := &pb.ServerList{}
+	for _, server := range config.AppEnv.Servers.ToServerResponses() {
+		response, err := serverResponseToProto(server)
+		if err != nil {
+			return nil, err
+		}
+		list.Servers = append(list.Servers, response)
+	}
+	return list, nil
+}
+
+func (s *GrpcServer) AddServer(ctx context.Context, request *pb.Server) (*pb.ServerResponse, error) {
+	var server structs.Server
+	if err := fromProto(request, &server); err != nil {
+		zap.L().Error("Failed to decode server", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, "Failed to decode server")
+	}
+
+	if errs := server.Validate(); len(errs) > 0 {
+		return nil, grpcValidationError(errs)
+	}
+
+	if addServerFunc == nil {
+		zap.L().Error("Add server function not set")
+		return nil, status.Error(codes.Internal, "Server configuration error")
+	}
+
+	newServer, err := addServerFunc(&server)
+	server.ResetLiveInfo()
+
+	if err != nil {
+		zap.L().Error("Failed to add server", zap.Error(err))
+		return nil, grpcServerError("Failed to add server", err)
+	}
+
+	return serverResponseToProto(newServer.ToServerResponse())
+}
+
+func (s *GrpcServer) UpdateServer(ctx context.Context, request *pb.UpdateServerRequest) (*pb.ServerResponse, error) {
+	var server structs.Server
+	if err := fromProto(request.GetServer(), &server); err != nil {
+		zap.L().Error("Failed to decode server", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, "Failed to decode server")
+	}
+
+	if errs := server.Validate(); len(errs) > 0 {
+		return nil, grpcValidationError(errs)
+	}
+
+	if updateServerFunc == nil {
+		zap.L().Error("Update server function not set")
+		return nil, status.Error(codes.Internal, "Server configuration error")
+	}
+
+	updatedServer, err := updateServerFunc(request.GetServerUuid(), &server)
+	if err != nil {
+		zap.L().Error("Failed to update server", zap.Error(err))
+		return nil, grpcServerError("Failed to update server", err)
+	}
+
+	server.ResetLiveInfo()
+
+	return serverResponseToProto(updatedServer.ToServerResponse())
+}
+
+func (s *GrpcServer) DeleteServer(ctx context.Context, request *pb.ServerRequest) (*emptypb.Empty, error) {
+	if removeServerFunc == nil {
+		zap.L().Error("Remove server function not set")
+		return nil, status.Error(codes.Internal, "Server configuration error")
+	}
+
+	if err := removeServerFunc(request.GetServerUuid()); err != nil {
+		zap.L().Error("Failed to remove server", zap.Error(err))
+		return nil, grpcServerError("Failed to remove server", err)
+	}
+
+	// Broadcast updated server list
+	BroadcastServers(config.AppEnv.Servers.ToServerResponses())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GrpcServer) GetChatConfig(ctx context.Context, request *pb.ServerRequest) (*pb.ChatConfig, error) {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	chatConfig := &pb.ChatConfig{}
+	if err := toProto(server.Info.Chat, chatConfig); err != nil {
+		zap.L().Error("Failed to encode chat config", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to encode chat config")
+	}
+	return chatConfig, nil
+}
+
+func (s *GrpcServer) UpdateChatConfig(ctx context.Context, request *pb.UpdateChatConfigRequest) (*pb.ChatConfig, error) {
+	var chatConfig structs.ChatConfig
+	if err := fromProto(request.GetConfig(), &chatConfig); err != nil {
+		zap.L().Error("Failed to decode chat config", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, "Failed to decode chat config")
+	}
+
+	if err := chatConfig.Moderation.CompileWordFilter(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid banned words")
+	}
+
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	if server.Client == nil || !server.Client.IsConnected {
+		return nil, status.Error(codes.Unavailable, "Server not connected")
+	}
+
+	if err := server.Client.ChatEnableManualRouting(chatConfig.ManualRouting, true); err != nil {
+		zap.L().Error("Failed to set manual routing", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to set manual routing")
+	}
+
+	server.Info.Chat = chatConfig
+	zap.L().Info("Updated chat config", zap.String("server_uuid", server.Uuid), zap.Any("chat_config", chatConfig))
+
+	return s.GetChatConfig(ctx, &pb.ServerRequest{ServerUuid: server.Uuid})
+}
+
+func (s *GrpcServer) GetPlayers(ctx context.Context, request *pb.ServerRequest) (*pb.PlayerList, error) {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	players := &pb.PlayerList{}
+	for _, player := range server.Info.ActivePlayers {
+		playerInfo := &pb.PlayerInfo{}
+		if err := toProto(player, playerInfo); err != nil {
+			zap.L().Error("Failed to encode player", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to encode player")
+		}
+		players.Players = append(players.Players, playerInfo)
+	}
+	return players, nil
+}
+
+func (s *GrpcServer) GetMap(ctx context.Context, request *pb.ServerRequest) (*pb.ActiveMap, error) {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActiveMap{Uid: server.Info.ActiveMap}, nil
+}
+
+func (s *GrpcServer) GetLiveInfo(ctx context.Context, request *pb.ServerRequest) (*pb.LiveInfo, error) {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	liveInfo := &pb.LiveInfo{}
+	if err := toProto(server.Info.LiveInfo, liveInfo); err != nil {
+		zap.L().Error("Failed to encode live info", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to encode live info")
+	}
+	return liveInfo, nil
+}
+
+func (s *GrpcServer) StreamServers(request *pb.StreamServersRequest, stream pb.GbxConnector_StreamServersServer) error {
+	sub := subscribeGrpc("servers", request.GetServerUuids()...)
+
+	send := func(event string, server *pb.ServerResponse) error {
+		return stream.Send(&pb.ServerEvent{Event: event, Server: server})
+	}
+
+	return streamGrpcEvents(stream.Context(), sub, func() error {
+		for _, server := range config.AppEnv.Servers.ToServerResponses() {
+			if len(sub.serverUuids) > 0 && !sub.serverUuids[server.Uuid] {
+				continue
+			}
+
+			response, err := serverResponseToProto(server)
+			if err != nil {
+				return err
+			}
+			if err := send("update", response); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(e grpcEvent) error {
+		// The payload of a deletion is only the uuid
+		if e.event == "delete" {
+			return send(e.event, &pb.ServerResponse{Uuid: e.serverUuid})
+		}
+
+		response := &pb.ServerResponse{}
+		if err := rawToProto(e.payload, response); err != nil {
+			return err
+		}
+		return send(e.event, response)
+	})
+}
+
+func (s *GrpcServer) StreamMap(request *pb.ServerRequest, stream pb.GbxConnector_StreamMapServer) error {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return err
+	}
+
+	sub := subscribeGrpc("map", server.Uuid)
+
+	return streamGrpcEvents(stream.Context(), sub, func() error {
+		return stream.Send(&pb.MapEvent{ServerUuid: server.Uuid, Event: "activeMap", MapUid: server.Info.ActiveMap})
+	}, func(e grpcEvent) error {
+		var mapUid string
+		if err := json.Unmarshal(e.payload, &mapUid); err != nil {
+			return err
+		}
+		return stream.Send(&pb.MapEvent{ServerUuid: e.serverUuid, Event: e.event, MapUid: mapUid})
+	})
+}
+
+func (s *GrpcServer) StreamPlayers(request *pb.ServerRequest, stream pb.GbxConnector_StreamPlayersServer) error {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return err
+	}
+
+	sub := subscribeGrpc("players", server.Uuid)
+
+	return streamGrpcEvents(stream.Context(), sub, func() error {
+		players, err := s.GetPlayers(stream.Context(), request)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pb.PlayersEvent{
+			ServerUuid: server.Uuid,
+			Event:      "playerList",
+			Payload:    &pb.PlayersEvent_Players{Players: players},
+		})
+	}, func(e grpcEvent) error {
+		event := &pb.PlayersEvent{ServerUuid: e.serverUuid, Event: e.event}
+
+		switch e.event {
+		case "playerList":
+			players := &pb.PlayerList{}
+			if err := rawToProto(wrapRaw("players", e.payload), players); err != nil {
+				return err
+			}
+			event.Payload = &pb.PlayersEvent_Players{Players: players}
+		case "disconnect":
+			var login string
+			if err := json.Unmarshal(e.payload, &login); err != nil {
+				return err
+			}
+			event.Payload = &pb.PlayersEvent_Login{Login: login}
+		default:
+			player := &pb.PlayerInfo{}
+			if err := rawToProto(e.payload, player); err != nil {
+				return err
+			}
+			event.Payload = &pb.PlayersEvent_Player{Player: player}
+		}
+
+		return stream.Send(event)
+	})
+}
+
+func (s *GrpcServer) StreamLive(request *pb.ServerRequest, stream pb.GbxConnector_StreamLiveServer) error {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return err
+	}
+
+	sub := subscribeGrpc("live", server.Uuid)
+
+	return streamGrpcEvents(stream.Context(), sub, func() error {
+		liveInfo, err := s.GetLiveInfo(stream.Context(), request)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pb.LiveEvent{
+			ServerUuid: server.Uuid,
+			Event:      "beginMatch",
+			Payload:    &pb.LiveEvent_LiveInfo{LiveInfo: liveInfo},
+		})
+	}, func(e grpcEvent) error {
+		event, err := liveEventToProto(e)
+		if err != nil {
+			return err
+		}
+		return stream.Send(event)
+	})
+}
+
+// Picks the payload type of a live event by its name
+func liveEventToProto(e grpcEvent) (*pb.LiveEvent, error) {
+	event := &pb.LiveEvent{ServerUuid: e.serverUuid, Event: e.event}
+
+	var err error
+	switch e.event {
+	case "beginMatch", "endRound", "personalBest", "playerConnect", "updatedSettings",
+		"warmUpStart", "warmUpEnd", "warmUpStartRound", "elimination":
+		liveInfo := &pb.LiveInfo{}
+		err = rawToProto(e.payload, liveInfo)
+		event.Payload = &pb.LiveEvent_LiveInfo{LiveInfo: liveInfo}
+	case "beginRound", "checkpoint", "finish", "giveUp", "lapFinish", "playerDisconnect", "playerInfoChanged":
+		activeRound := &pb.ActiveRound{}
+		err = rawToProto(e.payload, activeRound)
+		event.Payload = &pb.LiveEvent_ActiveRound{ActiveRound: activeRound}
+	case "beginMap", "endMap":
+		var mapUid string
+		err = json.Unmarshal(e.payload, &mapUid)
+		event.Payload = &pb.LiveEvent_MapUid{MapUid: mapUid}
+	case "split":
+		split := &pb.Split{}
+		err = rawToProto(e.payload, split)
+		event.Payload = &pb.LiveEvent_Split{Split: split}
+	case "positionChange":
+		changes := &pb.PositionChangeList{}
+		err = rawToProto(wrapRaw("changes", e.payload), changes)
+		event.Payload = &pb.LiveEvent_PositionChanges{PositionChanges: changes}
+	case "teamsChanged":
+		teams := &pb.TeamMap{}
+		err = rawToProto(wrapRaw("teams", e.payload), teams)
+		event.Payload = &pb.LiveEvent_Teams{Teams: teams}
+	case "knockout":
+		knockout := &pb.KnockoutInfo{}
+		err = rawToProto(e.payload, knockout)
+		event.Payload = &pb.LiveEvent_Knockout{Knockout: knockout}
+	case "seriesUpdate":
+		series := &pb.SeriesInfo{}
+		err = rawToProto(e.payload, series)
+		event.Payload = &pb.LiveEvent_Series{Series: series}
+	default:
+		data := &pb.LiveEvent_Data{}
+		data.Data, err = rawToValue(e.payload)
+		event.Payload = data
+	}
+
+	return event, err
+}
+
+func (s *GrpcServer) StreamChat(request *pb.ServerRequest, stream pb.GbxConnector_StreamChatServer) error {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return err
+	}
+
+	sub := subscribeGrpc("chat", server.Uuid)
+
+	send := func(event string, message any) error {
+		chatMessage := &pb.ChatMessage{}
+		if err := toProto(message, chatMessage); err != nil {
+			return err
+		}
+		return stream.Send(&pb.ChatEvent{ServerUuid: server.Uuid, Event: event, Message: chatMessage})
+	}
+
+	return streamGrpcEvents(stream.Context(), sub, func() error {
+		// Send the recent messages first, like the websocket backlog
+		for _, message := range getChatBacklog(server.Uuid) {
+			if err := send("backlog", message); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(e grpcEvent) error {
+		return send(e.event, e.payload)
+	})
+}
+
+func (s *GrpcServer) StreamScript(request *pb.ServerRequest, stream pb.GbxConnector_StreamScriptServer) error {
+	server, err := grpcServer(request.GetServerUuid())
+	if err != nil {
+		return err
+	}
+
+	sub := subscribeGrpc("script", server.Uuid)
+
+	return streamGrpcEvents(stream.Context(), sub, func() error {
+		return nil
+	}, func(e grpcEvent) error {
+		data, err := rawToValue(e.payload)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pb.ScriptEvent{ServerUuid: e.serverUuid, Event: e.event, Data: data})
+	})
+}
@@ -75,6 +75,8 @@ func HandleLiveConnection(w http.ResponseWriter, r *http.Request) {
 
 // Broadcast message to all connected clients
 func BroadcastLive(serverUuid string, message any) {
+	publishEvents(serverUuid, "live", message)
+
 	ls := GetLiveSocket(serverUuid)
 	if ls == nil {
 		zap.L().Error("Live socket not found", zap.String("server_uuid", serverUuid))
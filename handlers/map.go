@@ -74,6 +74,8 @@ func HandleMapConnection(w http.ResponseWriter, r *http.Request) {
 
 // Broadcast message to all connected clients
 func BroadcastMap(serverUuid string, message any) {
+	publishEvents(serverUuid, "map", message)
+
 	ms := GetMapSocket(serverUuid)
 	if ms == nil {
 		zap.L().Error("Map socket not found", zap.String("server_uuid", serverUuid))
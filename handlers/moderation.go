@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/middleware"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type MuteRequest struct {
+	Login    string `json:"login"`
+	Duration int    `json:"duration"` // Seconds
+	Reason   string `json:"reason,omitempty"`
+}
+
+// Returns the login of the authenticated user, empty for trusted local requests
+func requestUser(r *http.Request) string {
+	if user, ok := r.Context().Value(middleware.UserContextKey).(structs.User); ok {
+		return user.Login
+	}
+	return ""
+}
+
+// Delay of a scheduled moderation save, so the log entries of a chat burst are written at once
+const moderationSaveDelay = 10 * time.Second
+
+var (
+	moderationSave   *time.Timer
+	moderationSaveMu sync.Mutex
+)
+
+// ScheduleModerationSave persists the moderation after moderationSaveDelay, unless a save is already scheduled
+func ScheduleModerationSave() {
+	moderationSaveMu.Lock()
+	defer moderationSaveMu.Unlock()
+
+	if moderationSave != nil {
+		return
+	}
+
+	moderationSave = time.AfterFunc(moderationSaveDelay, SaveModeration)
+}
+
+// Persists the mutes and audit trail of all servers
+func SaveModeration() {
+	moderationSaveMu.Lock()
+	defer moderationSaveMu.Unlock()
+
+	if moderationSave != nil {
+		moderationSave.Stop()
+		moderationSave = nil
+	}
+
+	moderation := make(map[string]structs.ModerationState, len(config.AppEnv.Servers))
+	for _, server := range config.AppEnv.Servers {
+		if server.Info != nil && server.Info.Moderation != nil {
+			moderation[server.Uuid] = server.Info.Moderation.State()
+		}
+	}
+
+	if err := lib.WriteFile("./moderation.json", &moderation); err != nil {
+		zap.L().Error("Failed to write moderation.json", zap.Error(err))
+	}
+}
+
+func HandleGetMutes(w http.ResponseWriter, r *http.Request) {
+	serverUuid := mux.Vars(r)["uuid"]
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(server.Info.Moderation.Mutes()); err != nil {
+		zap.L().Error("Failed to encode mutes", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode mutes", err)
+	}
+}
+
+func HandleAddMute(w http.ResponseWriter, r *http.Request) {
+	serverUuid := mux.Vars(r)["uuid"]
+
+	var muteRequest MuteRequest
+	if err := json.NewDecoder(r.Body).Decode(&muteRequest); err != nil {
+		zap.L().Error("Failed to decode mute", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode mute", err)
+		return
+	}
+
+	if muteRequest.Login == "" || muteRequest.Duration <= 0 {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Login and a positive duration are required", nil)
+		return
+	}
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	mute := server.Info.Moderation.Mute(
+		muteRequest.Login,
+		time.Duration(muteRequest.Duration)*time.Second,
+		muteRequest.Reason,
+		requestUser(r),
+	)
+	zap.L().Info("Player muted", zap.String("server_uuid", serverUuid), zap.String("login", mute.Login), zap.Time("until", mute.Until))
+	SaveModeration()
+
+	if err := json.NewEncoder(w).Encode(mute); err != nil {
+		zap.L().Error("Failed to encode mute", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode mute", err)
+	}
+}
+
+func HandleDeleteMute(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+	login := vars["login"]
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	if !server.Info.Moderation.Unmute(login, requestUser(r)) {
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeNotFound, "Player is not muted", nil)
+		return
+	}
+
+	zap.L().Info("Player unmuted", zap.String("server_uuid", serverUuid), zap.String("login", login))
+	SaveModeration()
+	w.WriteHeader(http.StatusOK)
+}
+
+func HandleGetModerationLog(w http.ResponseWriter, r *http.Request) {
+	serverUuid := mux.Vars(r)["uuid"]
+	query := r.URL.Query()
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	entries := server.Info.Moderation.Logs(query.Get("login"), structs.ModerationAction(query.Get("action")))
+	if err := json.NewEncoder(w).Encode(entries); err != nil {
+		zap.L().Error("Failed to encode moderation log", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode moderation log", err)
+	}
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/MRegterschot/GbxConnector/structs"
+	"go.uber.org/zap"
+)
+
+//go:embed docs.html
+var docsPage []byte
+
+var openAPISpec []byte
+
+func SetOpenAPISpec(spec []byte) {
+	openAPISpec = spec
+}
+
+// Serves the OpenAPI document of the REST API and streams
+func HandleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if openAPISpec == nil {
+		zap.L().Error("OpenAPI document not set")
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "OpenAPI document not available", nil)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPISpec)
+}
+
+// Serves a page that renders the OpenAPI document, without external resources
+func HandleDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(docsPage)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/MRegterschot/GbxConnector/config"
@@ -78,6 +79,8 @@ func HandlePlayersConnection(w http.ResponseWriter, r *http.Request) {
 
 // Broadcast message to all connected clients
 func BroadcastPlayers(serverUuid string, message any) {
+	publishEvents(serverUuid, "players", message)
+
 	ps := GetPlayersSocket(serverUuid)
 	if ps == nil {
 		zap.L().Error("Players socket not found", zap.String("server_uuid", serverUuid))
@@ -95,3 +98,19 @@ func BroadcastPlayers(serverUuid string, message any) {
 		}
 	}
 }
+
+// Returns the sessions and playtime of a player on all servers
+func HandleGetPlayer(w http.ResponseWriter, r *http.Request) {
+	login := mux.Vars(r)["login"]
+
+	history := config.AppEnv.Sessions.History(login)
+	if history.Sessions == 0 {
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeNotFound, "Player not found", nil)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(history); err != nil {
+		zap.L().Error("Failed to encode player history", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode player history", err)
+	}
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrScriptTimeout            = errors.New("timed out waiting for script callback")
+	ErrScriptCallbackNotAllowed = errors.New("script callback not allowed")
+)
+
+var scriptSockets = make(map[string]*structs.SocketClients) // Map of socket clients by server ID
+
+type ScriptTriggerFunc func(server *structs.Server, request structs.ScriptTriggerRequest) (structs.ScriptTriggerResponse, error)
+
+var scriptTriggerFunc ScriptTriggerFunc
+
+func SetScriptTriggerFunc(fn ScriptTriggerFunc) {
+	scriptTriggerFunc = fn
+}
+
+func GetScriptSocket(serverUuid string) *structs.SocketClients {
+	if _, ok := scriptSockets[serverUuid]; !ok {
+		scriptSockets[serverUuid] = &structs.SocketClients{
+			Clients: make(map[*websocket.Conn]bool),
+		}
+	}
+	return scriptSockets[serverUuid]
+}
+
+// WebSocket connection handler
+func HandleScriptConnection(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		zap.L().Error("Failed to upgrade connection", zap.Error(err))
+		return
+	}
+
+	// Save connection
+	ss := GetScriptSocket(serverUuid)
+	ss.ClientsMu.Lock()
+	ss.Clients[conn] = true
+	ss.ClientsMu.Unlock()
+
+	// Handle disconnection
+	go func() {
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				zap.L().Info("WebSocket connection closed", zap.String("remoteAddr", conn.RemoteAddr().String()), zap.String("server_uuid", serverUuid))
+				ss.ClientsMu.Lock()
+				delete(ss.Clients, conn)
+				ss.ClientsMu.Unlock()
+				conn.Close()
+				break
+			}
+		}
+	}()
+}
+
+// Broadcast a script callback to all connected clients
+func BroadcastScript(serverUuid string, name string, data json.RawMessage) {
+	publishRawEvent(serverUuid, "script", name, data)
+
+	ss := GetScriptSocket(serverUuid)
+	if ss == nil {
+		zap.L().Error("Script socket not found", zap.String("server_uuid", serverUuid))
+		return
+	}
+
+	ss.ClientsMu.Lock()
+	defer ss.ClientsMu.Unlock()
+
+	for conn := range ss.Clients {
+		if err := conn.WriteJSON(map[string]json.RawMessage{
+			name: data,
+		}); err != nil {
+			zap.L().Error("Failed to send message to client", zap.Error(err))
+			conn.Close()
+			delete(ss.Clients, conn)
+		}
+	}
+}
+
+func HandleTriggerScriptEvent(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+
+	var request structs.ScriptTriggerRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		zap.L().Error("Failed to decode script event", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode script event", err)
+		return
+	}
+
+	if strings.TrimSpace(request.Method) == "" {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Method is required", nil)
+		return
+	}
+
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return
+	}
+
+	if scriptTriggerFunc == nil {
+		zap.L().Error("Script trigger function not set")
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Server configuration error", nil)
+		return
+	}
+
+	response, err := scriptTriggerFunc(server, request)
+	if errors.Is(err, ErrScriptCallbackNotAllowed) {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Callback must be in the script callbacks of the server or a status callback", nil)
+		return
+	}
+	if errors.Is(err, ErrScriptTimeout) {
+		zap.L().Error("Script event timed out", zap.String("server_uuid", serverUuid), zap.String("method", request.Method), zap.String("callback", request.Callback))
+		writeError(w, r, http.StatusGatewayTimeout, structs.ErrorCodeTimeout, "Timed out waiting for script callback", nil)
+		return
+	}
+	if err != nil {
+		zap.L().Error("Failed to trigger script event", zap.String("server_uuid", serverUuid), zap.String("method", request.Method), zap.Error(err))
+		writeError(w, r, http.StatusBadGateway, structs.ErrorCodeUpstream, "Failed to trigger script event", err)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		zap.L().Error("Failed to encode script response", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode script response", err)
+	}
+}
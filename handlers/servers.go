@@ -2,11 +2,13 @@ package handlers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"sync"
 
 	"github.com/MRegterschot/GbxConnector/config"
 	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/middleware"
 	"github.com/MRegterschot/GbxConnector/structs"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
@@ -35,10 +37,14 @@ var serverSocket = &ServerSocket{
 type ServerAdderFunc func(server *structs.Server) (*structs.Server, error)
 type ServerRemoverFunc func(serverUuid string) error
 type ServerUpdaterFunc func(serverUuid string, server *structs.Server) (*structs.Server, error)
+type ServerTesterFunc func(server *structs.Server) structs.ServerTestResult
+type ServerImporterFunc func(servers []*structs.Server, replace bool) (structs.ServerImportResult, error)
 
 var addServerFunc ServerAdderFunc
 var removeServerFunc ServerRemoverFunc
 var updateServerFunc ServerUpdaterFunc
+var testServerFunc ServerTesterFunc
+var importServersFunc ServerImporterFunc
 
 func SetAddServerFunc(fn ServerAdderFunc) {
 	addServerFunc = fn
@@ -52,6 +58,14 @@ func SetUpdateServerFunc(fn ServerUpdaterFunc) {
 	updateServerFunc = fn
 }
 
+func SetTestServerFunc(fn ServerTesterFunc) {
+	testServerFunc = fn
+}
+
+func SetImportServersFunc(fn ServerImporterFunc) {
+	importServersFunc = fn
+}
+
 // WebSocket connection handler
 func HandleServersConnection(w http.ResponseWriter, r *http.Request) {
 	conn, err := upgrader.Upgrade(w, r, nil)
@@ -110,6 +124,11 @@ func HandleServersConnection(w http.ResponseWriter, r *http.Request) {
 // Broadcast message to all connected clients
 // This function is generic and can be used to send any type of message
 func BroadcastServers(allServers []structs.ServerResponse) {
+	for _, server := range allServers {
+		publishEvent(server.Uuid, "servers", "update", server)
+	}
+	publishServersSSE(allServers)
+
 	serverSocket.ClientsMu.Lock()
 	defer serverSocket.ClientsMu.Unlock()
 
@@ -127,12 +146,17 @@ func BroadcastServers(allServers []structs.ServerResponse) {
 	}
 }
 
+// Publishes the deletion of a server, the payload is the uuid of the server
+func BroadcastServerDeleted(serverUuid string) {
+	publishEvent(serverUuid, "servers", "delete", serverUuid)
+}
+
 // Handle GET request to retrieve server information
 func HandleGetServers(w http.ResponseWriter, r *http.Request) {
 	servers := config.AppEnv.Servers.ToServerResponses()
 	if err := json.NewEncoder(w).Encode(servers); err != nil {
 		zap.L().Error("Failed to encode servers response", zap.Error(err))
-		http.Error(w, "Failed to encode servers response", http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode servers response", err)
 		return
 	}
 }
@@ -142,13 +166,18 @@ func HandleAddServer(w http.ResponseWriter, r *http.Request) {
 	var server structs.Server
 	if err := json.NewDecoder(r.Body).Decode(&server); err != nil {
 		zap.L().Error("Failed to decode server", zap.Error(err))
-		http.Error(w, "Failed to decode server", http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode server", err)
+		return
+	}
+
+	if errs := server.Validate(); len(errs) > 0 {
+		writeValidationError(w, r, errs)
 		return
 	}
 
 	if addServerFunc == nil {
 		zap.L().Error("Add server function not set")
-		http.Error(w, "Server configuration error", http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Server configuration error", nil)
 		return
 	}
 
@@ -157,14 +186,14 @@ func HandleAddServer(w http.ResponseWriter, r *http.Request) {
 
 	if err != nil {
 		zap.L().Error("Failed to add server", zap.Error(err))
-		http.Error(w, "Failed to add server", http.StatusInternalServerError)
+		writeServerError(w, r, "Failed to add server", err)
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(newServer.ToServerResponse()); err != nil {
 		zap.L().Error("Failed to encode server response", zap.Error(err))
-		http.Error(w, "Failed to encode server response", http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode server response", err)
 		return
 	}
 }
@@ -176,13 +205,13 @@ func HandleDeleteServer(w http.ResponseWriter, r *http.Request) {
 
 	if removeServerFunc == nil {
 		zap.L().Error("Remove server function not set")
-		http.Error(w, "Server configuration error", http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Server configuration error", nil)
 		return
 	}
 
 	if err := removeServerFunc(serverUuid); err != nil {
 		zap.L().Error("Failed to remove server", zap.Error(err))
-		http.Error(w, "Failed to remove server", http.StatusInternalServerError)
+		writeServerError(w, r, "Failed to remove server", err)
 		return
 	}
 
@@ -200,20 +229,25 @@ func HandleUpdateServer(w http.ResponseWriter, r *http.Request) {
 	var server structs.Server
 	if err := json.NewDecoder(r.Body).Decode(&server); err != nil {
 		zap.L().Error("Failed to decode server", zap.Error(err))
-		http.Error(w, "Failed to decode server", http.StatusBadRequest)
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode server", err)
+		return
+	}
+
+	if errs := server.Validate(); len(errs) > 0 {
+		writeValidationError(w, r, errs)
 		return
 	}
 
 	if updateServerFunc == nil {
 		zap.L().Error("Update server function not set")
-		http.Error(w, "Server configuration error", http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Server configuration error", nil)
 		return
 	}
 
 	updatedServer, err := updateServerFunc(serverUuid, &server)
 	if err != nil {
 		zap.L().Error("Failed to update server", zap.Error(err))
-		http.Error(w, "Failed to update server", http.StatusInternalServerError)
+		writeServerError(w, r, "Failed to update server", err)
 		return
 	}
 
@@ -222,7 +256,122 @@ func HandleUpdateServer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedServer.ToServerResponse()); err != nil {
 		zap.L().Error("Failed to encode server response", zap.Error(err))
-		http.Error(w, "Failed to encode server response", http.StatusInternalServerError)
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode server response", err)
+		return
+	}
+}
+
+// HandleTestServer connects and authenticates with the given server without saving it
+func HandleTestServer(w http.ResponseWriter, r *http.Request) {
+	var server structs.Server
+	if err := json.NewDecoder(r.Body).Decode(&server); err != nil {
+		zap.L().Error("Failed to decode server", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode server", err)
+		return
+	}
+
+	if errs := server.Validate(); len(errs) > 0 {
+		writeValidationError(w, r, errs)
+		return
+	}
+
+	if testServerFunc == nil {
+		zap.L().Error("Test server function not set")
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Server configuration error", nil)
+		return
+	}
+
+	result := testServerFunc(&server)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		zap.L().Error("Failed to encode test result", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode test result", err)
+	}
+}
+
+// HandleExportServers returns the server configurations in the format accepted by the import,
+// with ?redact=true the passwords and Discord webhooks are left out
+func HandleExportServers(w http.ResponseWriter, r *http.Request) {
+	redact := r.URL.Query().Get("redact") == "true"
+
+	servers := make([]structs.Server, len(config.AppEnv.Servers))
+	for i, server := range config.AppEnv.Servers {
+		servers[i] = server.ToExport(redact)
+	}
+
+	w.Header().Set("Content-Disposition", `attachment; filename="servers.json"`)
+	if err := json.NewEncoder(w).Encode(servers); err != nil {
+		zap.L().Error("Failed to encode servers export", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode servers export", err)
+	}
+}
+
+// HandleImportServers adds a list of servers and updates the existing ones with the uuid of an imported server,
+// ?mode=merge (default) keeps the other existing servers, ?mode=replace removes them.
+// Nothing is imported when a server is invalid or conflicts.
+func HandleImportServers(w http.ResponseWriter, r *http.Request) {
+	mode := r.URL.Query().Get("mode")
+	if mode == "" {
+		mode = "merge"
+	}
+	if mode != "merge" && mode != "replace" {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Mode must be merge or replace", nil)
 		return
 	}
+	replace := mode == "replace"
+
+	var imports []structs.ServerImport
+	if err := json.NewDecoder(r.Body).Decode(&imports); err != nil {
+		zap.L().Error("Failed to decode servers import", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode servers import", err)
+		return
+	}
+
+	servers := make([]*structs.Server, len(imports))
+	fieldErrs := []structs.FieldError{}
+	for i := range imports {
+		servers[i] = imports[i].ToServer()
+
+		// A redacted export has no secrets, they are kept from the existing server with the same uuid
+		if existing := config.AppEnv.Servers.GetByUuid(servers[i].Uuid); existing != nil {
+			servers[i].KeepSecrets(existing)
+		}
+
+		for _, fieldErr := range servers[i].Validate() {
+			fieldErr.Field = fmt.Sprintf("[%d].%s", i, fieldErr.Field)
+			fieldErrs = append(fieldErrs, fieldErr)
+		}
+	}
+
+	if len(fieldErrs) > 0 {
+		writeValidationError(w, r, fieldErrs)
+		return
+	}
+
+	// With replace only the imported servers can conflict with each other
+	existing := config.AppEnv.Servers
+	if replace {
+		existing = nil
+	}
+	if conflicts := existing.Conflicts(servers); len(conflicts) > 0 {
+		middleware.WriteError(w, r, http.StatusConflict, structs.ErrorCodeServerExists, "Servers with the same host and port already exist", map[string][]structs.ServerConflict{"conflicts": conflicts})
+		return
+	}
+
+	if importServersFunc == nil {
+		zap.L().Error("Import servers function not set")
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Server configuration error", nil)
+		return
+	}
+
+	result, err := importServersFunc(servers, replace)
+	if err != nil {
+		zap.L().Error("Failed to import servers", zap.Error(err))
+		writeServerError(w, r, "Failed to import servers", err)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		zap.L().Error("Failed to encode import result", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode import result", err)
+	}
 }
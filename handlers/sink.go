@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// EventSink receives every event that is sent to the websockets, e.g. to publish it on a message broker
+type EventSink interface {
+	Publish(serverUuid string, topic string, event string, payload json.RawMessage) error
+	Close() error
+}
+
+// Implemented by the sinks that only need events while clients are connected
+type activeEventSink interface {
+	Active() bool
+}
+
+var (
+	eventSinks   []EventSink
+	eventSinksMu sync.RWMutex
+)
+
+func AddEventSink(sink EventSink) {
+	eventSinksMu.Lock()
+	defer eventSinksMu.Unlock()
+
+	eventSinks = append(eventSinks, sink)
+}
+
+func CloseEventSinks() {
+	eventSinksMu.Lock()
+	defer eventSinksMu.Unlock()
+
+	for _, sink := range eventSinks {
+		if err := sink.Close(); err != nil {
+			zap.L().Error("Failed to close event sink", zap.Error(err))
+		}
+	}
+	eventSinks = nil
+}
+
+// Whether any sink needs the events, so the payloads are only encoded when they are sent somewhere
+func hasEventSinks() bool {
+	eventSinksMu.RLock()
+	defer eventSinksMu.RUnlock()
+
+	for _, sink := range eventSinks {
+		if active, ok := sink.(activeEventSink); !ok || active.Active() {
+			return true
+		}
+	}
+	return false
+}
+
+// Publishes a websocket message, every key of the message is an event with its payload
+func publishEvents(serverUuid string, topic string, message any) {
+	if !hasEventSinks() {
+		return
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		zap.L().Error("Failed to encode event", zap.String("topic", topic), zap.Error(err))
+		return
+	}
+
+	var events map[string]json.RawMessage
+	if err := json.Unmarshal(data, &events); err != nil {
+		zap.L().Error("Failed to decode event", zap.String("topic", topic), zap.Error(err))
+		return
+	}
+
+	for event, payload := range events {
+		publishRawEvent(serverUuid, topic, event, payload)
+	}
+}
+
+func publishEvent(serverUuid string, topic string, event string, payload any) {
+	if !hasEventSinks() {
+		return
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		zap.L().Error("Failed to encode event", zap.String("topic", topic), zap.String("event", event), zap.Error(err))
+		return
+	}
+
+	publishRawEvent(serverUuid, topic, event, data)
+}
+
+func publishRawEvent(serverUuid string, topic string, event string, payload json.RawMessage) {
+	eventSinksMu.RLock()
+	defer eventSinksMu.RUnlock()
+
+	for _, sink := range eventSinks {
+		if err := sink.Publish(serverUuid, topic, event, payload); err != nil {
+			zap.L().Error("Failed to publish event", zap.String("server_uuid", serverUuid), zap.String("topic", topic), zap.String("event", event), zap.Error(err))
+		}
+	}
+}
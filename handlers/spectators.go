@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+var playerModes = map[string]int{
+	"userSelectable": structs.PlayerModeUserSelectable,
+	"spectator":      structs.PlayerModeSpectator,
+	"player":         structs.PlayerModePlayer,
+	"spectatorFree":  structs.PlayerModeSpectatorFree,
+}
+
+// Returns the connected server of the request, writes the error response if there is none
+func connectedServer(w http.ResponseWriter, r *http.Request, serverUuid string) *structs.Server {
+	server := config.AppEnv.Servers.GetByUuid(serverUuid)
+	if server == nil {
+		zap.L().Error("Server not found", zap.String("server_uuid", serverUuid))
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeServerNotFound, "Server not found", nil)
+		return nil
+	}
+
+	if server.Client == nil || !server.Client.IsConnected {
+		writeError(w, r, http.StatusServiceUnavailable, structs.ErrorCodeServerNotConnected, "Server not connected", nil)
+		return nil
+	}
+
+	return server
+}
+
+// HandleSetPlayerMode forces a player into spectator or player mode
+func HandleSetPlayerMode(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+	login := vars["login"]
+
+	var request structs.PlayerModeRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		zap.L().Error("Failed to decode player mode", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode player mode", err)
+		return
+	}
+
+	mode, ok := playerModes[request.Mode]
+	if !ok {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Mode must be spectator, player, spectatorFree or userSelectable", nil)
+		return
+	}
+
+	server := connectedServer(w, r, serverUuid)
+	if server == nil {
+		return
+	}
+
+	if err := server.Client.ForceSpectator(login, mode); err != nil {
+		zap.L().Error("Failed to force player mode", zap.String("server_uuid", serverUuid), zap.String("login", login), zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to force player mode", err)
+		return
+	}
+
+	zap.L().Info("Player mode forced", zap.String("server_uuid", serverUuid), zap.String("login", login), zap.String("mode", request.Mode))
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleSetSpectatorTarget sets the player a spectator is watching
+func HandleSetSpectatorTarget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	serverUuid := vars["uuid"]
+	login := vars["login"]
+
+	var request structs.SpectatorTargetRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		zap.L().Error("Failed to decode spectator target", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode spectator target", err)
+		return
+	}
+
+	camera := -1
+	if request.Camera != nil {
+		camera = *request.Camera
+	}
+
+	if camera < -1 || camera > 2 {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Camera must be between -1 and 2", nil)
+		return
+	}
+
+	server := connectedServer(w, r, serverUuid)
+	if server == nil {
+		return
+	}
+
+	if err := server.Client.ForceSpectatorTarget(login, request.Target, camera); err != nil {
+		zap.L().Error("Failed to set spectator target", zap.String("server_uuid", serverUuid), zap.String("login", login), zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to set spectator target", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleSetSlots sets the max players and max spectators of a server
+func HandleSetSlots(w http.ResponseWriter, r *http.Request) {
+	serverUuid := mux.Vars(r)["uuid"]
+
+	var request structs.SlotsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		zap.L().Error("Failed to decode slots", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode slots", err)
+		return
+	}
+
+	// Both values are validated before any is set, so the server isn't left half updated
+	if errs := request.Validate(); len(errs) > 0 {
+		writeValidationError(w, r, errs)
+		return
+	}
+
+	server := connectedServer(w, r, serverUuid)
+	if server == nil {
+		return
+	}
+
+	if request.MaxPlayers != nil {
+		if err := server.Client.SetMaxPlayers(*request.MaxPlayers); err != nil {
+			zap.L().Error("Failed to set max players", zap.String("server_uuid", serverUuid), zap.Error(err))
+			writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to set max players", err)
+			return
+		}
+	}
+
+	if request.MaxSpectators != nil {
+		if err := server.Client.SetMaxSpectators(*request.MaxSpectators); err != nil {
+			zap.L().Error("Failed to set max spectators", zap.String("server_uuid", serverUuid), zap.Error(err))
+			message := "Failed to set max spectators"
+			if request.MaxPlayers != nil {
+				message = "Max players was set, but setting max spectators failed"
+			}
+			writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, message, err)
+			return
+		}
+	}
+
+	zap.L().Info("Server slots updated", zap.String("server_uuid", serverUuid))
+	w.WriteHeader(http.StatusOK)
+}
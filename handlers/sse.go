@@ -0,0 +1,334 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	sseBufferSize        = 256 // Events kept per stream to resume from
+	sseClientBufferSize  = 64
+	sseHeartbeatInterval = 15 * time.Second
+	sseResumeWindow      = time.Minute // Events are kept for this long after the last client disconnected
+)
+
+type sseEvent struct {
+	id    uint64
+	event string // Empty for unnamed messages, like the websocket messages without an event name
+	data  any
+}
+
+// An event stream of a topic, keeps the latest events so clients can resume with Last-Event-ID
+type sseStream struct {
+	mu      sync.Mutex
+	seq     uint64
+	events  []sseEvent
+	clients map[chan sseEvent]bool
+}
+
+var (
+	sseStreams   = make(map[string]*sseStream) // Streams by topic and server ID
+	sseStreamsMu sync.Mutex
+)
+
+var (
+	sseClients      int
+	sseLastClientAt time.Time
+	sseClientsMu    sync.Mutex
+)
+
+// Streams the events of the topics that have an SSE endpoint
+type sseSink struct{}
+
+func init() {
+	AddEventSink(sseSink{})
+}
+
+func (sseSink) Publish(serverUuid string, topic string, event string, payload json.RawMessage) error {
+	switch topic {
+	case "map", "players", "live":
+		getSSEStream(topic, serverUuid).publish(event, payload)
+	}
+	return nil
+}
+
+func (sseSink) Close() error {
+	return nil
+}
+
+// The streams are active while clients are connected and for the resume window after the last one left
+func (sseSink) Active() bool {
+	sseClientsMu.Lock()
+	defer sseClientsMu.Unlock()
+
+	return sseActive()
+}
+
+func sseActive() bool {
+	return sseClients > 0 || time.Since(sseLastClientAt) < sseResumeWindow
+}
+
+func addSSEClient() {
+	sseClientsMu.Lock()
+	defer sseClientsMu.Unlock()
+
+	// Events were skipped while no client was connected, the buffered events can't be resumed from
+	if !sseActive() {
+		sseStreamsMu.Lock()
+		for _, stream := range sseStreams {
+			stream.reset()
+		}
+		sseStreamsMu.Unlock()
+	}
+	sseClients++
+}
+
+func removeSSEClient() {
+	sseClientsMu.Lock()
+	defer sseClientsMu.Unlock()
+
+	sseClients--
+	sseLastClientAt = time.Now()
+}
+
+func getSSEStream(topic string, serverUuid string) *sseStream {
+	sseStreamsMu.Lock()
+	defer sseStreamsMu.Unlock()
+
+	key := topic + ":" + serverUuid
+	if _, ok := sseStreams[key]; !ok {
+		sseStreams[key] = &sseStream{
+			clients: make(map[chan sseEvent]bool),
+		}
+	}
+	return sseStreams[key]
+}
+
+func (s *sseStream) publish(event string, data any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	e := sseEvent{id: s.seq, event: event, data: data}
+
+	s.events = append(s.events, e)
+	if len(s.events) > sseBufferSize {
+		s.events = s.events[len(s.events)-sseBufferSize:]
+	}
+
+	for ch := range s.clients {
+		select {
+		case ch <- e:
+		default:
+			// The client can't keep up, it can reconnect and resume from its last event
+			close(ch)
+			delete(s.clients, ch)
+		}
+	}
+}
+
+// Drops the buffered events, the next id is not the successor of any id sent before
+func (s *sseStream) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	s.events = nil
+}
+
+// Adds a client to the stream. When resuming, the missed events are returned if they are still buffered,
+// otherwise the snapshot is taken while no events can be published.
+func (s *sseStream) subscribe(lastId uint64, resume bool, snapshot func() []sseEvent) (chan sseEvent, []sseEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan sseEvent, sseClientBufferSize)
+	s.clients[ch] = true
+
+	if !resume || lastId > s.seq {
+		return ch, s.snapshot(snapshot)
+	}
+
+	missed := []sseEvent{}
+	for _, e := range s.events {
+		if e.id > lastId {
+			missed = append(missed, e)
+		}
+	}
+
+	// Events between the last id and the buffer were dropped, the client needs a new snapshot
+	if lastId < s.seq && (len(missed) == 0 || missed[0].id != lastId+1) {
+		return ch, s.snapshot(snapshot)
+	}
+
+	return ch, missed
+}
+
+// The snapshot events get the id of the latest event, so resuming continues after it
+func (s *sseStream) snapshot(snapshot func() []sseEvent) []sseEvent {
+	events := snapshot()
+	for i := range events {
+		events[i].id = s.seq
+	}
+	return events
+}
+
+func (s *sseStream) unsubscribe(ch chan sseEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.clients[ch] {
+		close(ch)
+		delete(s.clients, ch)
+	}
+}
+
+// Serves the stream as text/event-stream, starting with the snapshot unless the client resumed
+func serveSSE(w http.ResponseWriter, r *http.Request, stream *sseStream, snapshot func() []sseEvent, transform func(data any) any) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Streaming not supported", nil)
+		return
+	}
+
+	lastEventId := r.Header.Get("Last-Event-ID")
+	if lastEventId == "" {
+		lastEventId = r.URL.Query().Get("lastEventId")
+	}
+	lastId, err := strconv.ParseUint(lastEventId, 10, 64)
+
+	addSSEClient()
+	defer removeSSEClient()
+
+	ch, events := stream.subscribe(lastId, err == nil, snapshot)
+	defer stream.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	for _, e := range events {
+		if err := writeSSEEvent(w, e, transform); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case e, ok := <-ch:
+			if !ok {
+				return
+			}
+			if err := writeSSEEvent(w, e, transform); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeSSEEvent(w http.ResponseWriter, e sseEvent, transform func(data any) any) error {
+	data := e.data
+	if transform != nil {
+		data = transform(data)
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		zap.L().Error("Failed to encode event", zap.String("event", e.event), zap.Error(err))
+		return nil
+	}
+
+	if e.event != "" {
+		if _, err := fmt.Fprintf(w, "event: %s\n", e.event); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", e.id, body)
+	return err
+}
+
+// Publishes the server list on the servers stream, every client gets the servers it subscribed to
+func publishServersSSE(allServers []structs.ServerResponse) {
+	getSSEStream("servers", "").publish("", allServers)
+}
+
+func HandleServersSSE(w http.ResponseWriter, r *http.Request) {
+	subscriptionSet := make(map[string]bool)
+	for _, uuid := range r.URL.Query()["serverUuid"] {
+		subscriptionSet[uuid] = true
+	}
+
+	snapshot := func() []sseEvent {
+		return []sseEvent{{data: config.AppEnv.Servers.ToServerResponses()}}
+	}
+	serveSSE(w, r, getSSEStream("servers", ""), snapshot, func(data any) any {
+		if servers, ok := data.([]structs.ServerResponse); ok {
+			return lib.FilterServersByUuid(servers, subscriptionSet)
+		}
+		return data
+	})
+}
+
+func HandleMapSSE(w http.ResponseWriter, r *http.Request) {
+	serverUuid := mux.Vars(r)["uuid"]
+
+	snapshot := func() []sseEvent {
+		var activeMap string
+		if server := config.AppEnv.Servers.GetByUuid(serverUuid); server != nil {
+			activeMap = server.Info.ActiveMap
+		}
+		return []sseEvent{{data: activeMap}}
+	}
+	serveSSE(w, r, getSSEStream("map", serverUuid), snapshot, nil)
+}
+
+func HandlePlayersSSE(w http.ResponseWriter, r *http.Request) {
+	serverUuid := mux.Vars(r)["uuid"]
+
+	snapshot := func() []sseEvent {
+		activePlayers := make([]structs.PlayerInfo, 0)
+		if server := config.AppEnv.Servers.GetByUuid(serverUuid); server != nil && server.Info.ActivePlayers != nil {
+			activePlayers = server.Info.ActivePlayers
+		}
+		return []sseEvent{{event: "playerList", data: activePlayers}}
+	}
+	serveSSE(w, r, getSSEStream("players", serverUuid), snapshot, nil)
+}
+
+func HandleLiveSSE(w http.ResponseWriter, r *http.Request) {
+	serverUuid := mux.Vars(r)["uuid"]
+
+	snapshot := func() []sseEvent {
+		var liveInfo *structs.LiveInfo
+		if server := config.AppEnv.Servers.GetByUuid(serverUuid); server != nil {
+			liveInfo = server.Info.LiveInfo
+		}
+		return []sseEvent{{event: "beginMatch", data: liveInfo}}
+	}
+	serveSSE(w, r, getSSEStream("live", serverUuid), snapshot, nil)
+}
@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultWebhookRetries = 3
+	maxDeadLetters        = 1000
+)
+
+var (
+	deadLetters   = make([]structs.DeadLetter, 0)
+	deadLettersMu sync.Mutex
+)
+
+// DispatchWebhook sends the event to every webhook subscribed to it, each delivery runs in the background
+func DispatchWebhook(serverUuid string, event structs.WebhookEvent, data any) {
+	for _, webhook := range config.AppEnv.Webhooks {
+		if !webhook.Matches(serverUuid, event) {
+			continue
+		}
+
+		payload := structs.WebhookPayload{
+			Id:         uuid.NewString(),
+			Event:      event,
+			ServerUuid: serverUuid,
+			Time:       time.Now(),
+			Data:       data,
+		}
+
+		body, err := json.Marshal(payload)
+		if err != nil {
+			zap.L().Error("Failed to encode webhook payload", zap.String("webhook_id", webhook.Id), zap.Error(err))
+			continue
+		}
+
+		go deliverWebhook(*webhook, payload, body)
+	}
+}
+
+func deliverWebhook(webhook structs.Webhook, payload structs.WebhookPayload, body []byte) {
+	headers := map[string]string{
+		"X-Gbx-Event":    string(payload.Event),
+		"X-Gbx-Delivery": payload.Id,
+	}
+	if webhook.Secret != "" {
+		headers["X-Gbx-Signature"] = lib.SignPayload(webhook.Secret, body)
+	}
+
+	retries := webhook.MaxRetries
+	if retries <= 0 {
+		retries = defaultWebhookRetries
+	}
+
+	attempts, err := lib.PostWebhook(webhook.Url, body, headers, retries)
+	if err == nil {
+		zap.L().Debug("Webhook delivered", zap.String("webhook_id", webhook.Id), zap.String("event", string(payload.Event)), zap.Int("attempts", attempts))
+		return
+	}
+
+	zap.L().Error("Failed to deliver webhook", zap.String("webhook_id", webhook.Id), zap.String("event", string(payload.Event)), zap.Int("attempts", attempts), zap.Error(err))
+
+	deadLettersMu.Lock()
+	defer deadLettersMu.Unlock()
+
+	deadLetters = append(deadLetters, structs.DeadLetter{
+		WebhookId:  webhook.Id,
+		Url:        webhook.Url,
+		Event:      payload.Event,
+		ServerUuid: payload.ServerUuid,
+		Payload:    body,
+		Attempts:   attempts,
+		Error:      err.Error(),
+		Time:       time.Now(),
+	})
+	if len(deadLetters) > maxDeadLetters {
+		deadLetters = slices.Clone(deadLetters[len(deadLetters)-maxDeadLetters:])
+	}
+
+	if err := lib.WriteFile("./deadletters.json", &deadLetters); err != nil {
+		zap.L().Error("Failed to write deadletters.json", zap.Error(err))
+	}
+}
+
+// LoadDeadLetters restores the dead letters persisted in deadletters.json
+func LoadDeadLetters() {
+	entries := make([]structs.DeadLetter, 0)
+	if err := lib.ReadFile("./deadletters.json", &entries); err != nil {
+		return
+	}
+
+	deadLettersMu.Lock()
+	defer deadLettersMu.Unlock()
+
+	deadLetters = entries
+}
+
+// Returns the webhooks without their secrets
+func webhookResponses(webhooks []*structs.Webhook) []structs.WebhookResponse {
+	responses := make([]structs.WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		responses[i] = webhook.ToWebhookResponse()
+	}
+	return responses
+}
+
+func HandleGetWebhooks(w http.ResponseWriter, r *http.Request) {
+	if err := json.NewEncoder(w).Encode(webhookResponses(config.AppEnv.Webhooks)); err != nil {
+		zap.L().Error("Failed to encode webhooks", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode webhooks", err)
+	}
+}
+
+func HandleAddWebhook(w http.ResponseWriter, r *http.Request) {
+	var webhook structs.Webhook
+	if err := json.NewDecoder(r.Body).Decode(&webhook); err != nil {
+		zap.L().Error("Failed to decode webhook", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode webhook", err)
+		return
+	}
+
+	if msg := validateWebhook(&webhook); msg != "" {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, msg, nil)
+		return
+	}
+
+	webhook.Id = uuid.NewString()
+	webhooks := append(slices.Clone(config.AppEnv.Webhooks), &webhook)
+	if !saveWebhooks(w, r, webhooks) {
+		return
+	}
+
+	zap.L().Info("Webhook added", zap.String("webhook_id", webhook.Id))
+
+	if err := json.NewEncoder(w).Encode(webhook.ToWebhookResponse()); err != nil {
+		zap.L().Error("Failed to encode webhook", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode webhook", err)
+	}
+}
+
+func HandleUpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookId := mux.Vars(r)["id"]
+
+	var webhook structs.Webhook
+	if err := json.NewDecoder(r.Body).Decode(&webhook); err != nil {
+		zap.L().Error("Failed to decode webhook", zap.Error(err))
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, "Failed to decode webhook", err)
+		return
+	}
+
+	if msg := validateWebhook(&webhook); msg != "" {
+		writeError(w, r, http.StatusBadRequest, structs.ErrorCodeInvalidRequest, msg, nil)
+		return
+	}
+
+	i := slices.IndexFunc(config.AppEnv.Webhooks, func(wh *structs.Webhook) bool {
+		return wh.Id == webhookId
+	})
+	if i < 0 {
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeNotFound, "Webhook not found", nil)
+		return
+	}
+
+	// The secret is not returned by the API, so an empty secret keeps the current one
+	if webhook.Secret == "" {
+		webhook.Secret = config.AppEnv.Webhooks[i].Secret
+	}
+
+	webhook.Id = webhookId
+	webhooks := slices.Clone(config.AppEnv.Webhooks)
+	webhooks[i] = &webhook
+	if !saveWebhooks(w, r, webhooks) {
+		return
+	}
+
+	zap.L().Info("Webhook updated", zap.String("webhook_id", webhook.Id))
+
+	if err := json.NewEncoder(w).Encode(webhook.ToWebhookResponse()); err != nil {
+		zap.L().Error("Failed to encode webhook", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode webhook", err)
+	}
+}
+
+func HandleDeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	webhookId := mux.Vars(r)["id"]
+
+	webhooks := slices.DeleteFunc(slices.Clone(config.AppEnv.Webhooks), func(wh *structs.Webhook) bool {
+		return wh.Id == webhookId
+	})
+	if len(webhooks) == len(config.AppEnv.Webhooks) {
+		writeError(w, r, http.StatusNotFound, structs.ErrorCodeNotFound, "Webhook not found", nil)
+		return
+	}
+
+	if !saveWebhooks(w, r, webhooks) {
+		return
+	}
+
+	zap.L().Info("Webhook deleted", zap.String("webhook_id", webhookId))
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleGetDeadLetters returns the deliveries that failed after all retries
+func HandleGetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	deadLettersMu.Lock()
+	entries := slices.Clone(deadLetters)
+	deadLettersMu.Unlock()
+
+	if err := json.NewEncoder(w).Encode(entries); err != nil {
+		zap.L().Error("Failed to encode dead letters", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to encode dead letters", err)
+	}
+}
+
+// Returns an error message when the webhook is invalid
+func validateWebhook(webhook *structs.Webhook) string {
+	u, err := url.Parse(webhook.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "A valid http or https url is required"
+	}
+
+	for _, serverUuid := range webhook.Servers {
+		if config.AppEnv.Servers.GetByUuid(serverUuid) == nil {
+			return "Server not found: " + serverUuid
+		}
+	}
+
+	for _, event := range webhook.Events {
+		if !slices.Contains(structs.WebhookEvents, event) {
+			return "Unknown event: " + string(event)
+		}
+	}
+
+	if webhook.MaxRetries < 0 {
+		return "Max retries can't be negative"
+	}
+
+	return ""
+}
+
+func saveWebhooks(w http.ResponseWriter, r *http.Request, webhooks []*structs.Webhook) bool {
+	if err := lib.WriteFile("./webhooks.json", &webhooks); err != nil {
+		zap.L().Error("Failed to write webhooks.json", zap.Error(err))
+		writeError(w, r, http.StatusInternalServerError, structs.ErrorCodeInternal, "Failed to save webhooks", err)
+		return false
+	}
+
+	config.AppEnv.Webhooks = webhooks
+	return true
+}
@@ -0,0 +1,147 @@
+package lib
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+const (
+	discordEmbedColor = 0x2ecc71
+	discordMaxRanking = 10
+)
+
+var (
+	formattingRegex = regexp.MustCompile(`\$(?:\$|[0-9a-fA-F]{1,3}|[lLhHpP](?:\[[^\]]*\])?|.)`)
+	podiumMedals    = []string{"🥇", "🥈", "🥉"}
+)
+
+// Removes the Trackmania formatting codes from a text
+func StripFormatting(text string) string {
+	return formattingRegex.ReplaceAllStringFunc(text, func(code string) string {
+		if code == "$$" {
+			return "$"
+		}
+		return ""
+	})
+}
+
+// Formats the result of a finished match as a Discord webhook payload
+func FormatMatchResult(config structs.DiscordConfig, serverName string, mapUid string, mapName string, liveInfo *structs.LiveInfo) structs.DiscordWebhookPayload {
+	embed := structs.DiscordEmbed{
+		Title:     "Match finished on " + StripFormatting(serverName),
+		Color:     discordEmbedColor,
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+
+	// Discord rejects a footer without text
+	if liveInfo.Mode != "" {
+		embed.Footer = &structs.DiscordEmbedFooter{Text: liveInfo.Mode}
+	}
+
+	if mapName != "" {
+		embed.Fields = append(embed.Fields, structs.DiscordEmbedField{
+			Name:  "Map",
+			Value: StripFormatting(mapName),
+		})
+	}
+
+	if config.ThumbnailUrl != "" && mapUid != "" {
+		embed.Thumbnail = &structs.DiscordEmbedImage{
+			Url: strings.ReplaceAll(config.ThumbnailUrl, "{uid}", mapUid),
+		}
+	}
+
+	if len(liveInfo.Teams) > 0 {
+		embed.Fields = append(embed.Fields, structs.DiscordEmbedField{
+			Name:  "Teams",
+			Value: formatTeams(liveInfo),
+		})
+	}
+
+	ranking := rankPlayers(liveInfo)
+	if len(ranking) > 0 {
+		podium := []string{}
+		for i, player := range ranking[:min(len(ranking), len(podiumMedals))] {
+			podium = append(podium, podiumMedals[i]+" "+formatPlayerResult(player, liveInfo))
+		}
+
+		embed.Fields = append(embed.Fields, structs.DiscordEmbedField{
+			Name:  "Podium",
+			Value: strings.Join(podium, "\n"),
+		})
+	}
+
+	if len(ranking) > len(podiumMedals) {
+		rest := []string{}
+		for i, player := range ranking[len(podiumMedals):min(len(ranking), discordMaxRanking)] {
+			rest = append(rest, fmt.Sprintf("%d. %s", i+len(podiumMedals)+1, formatPlayerResult(player, liveInfo)))
+		}
+
+		embed.Fields = append(embed.Fields, structs.DiscordEmbedField{
+			Name:  "Ranking",
+			Value: strings.Join(rest, "\n"),
+		})
+	}
+
+	return structs.DiscordWebhookPayload{
+		Username: config.Username,
+		Embeds:   []structs.DiscordEmbed{embed},
+	}
+}
+
+func formatTeams(liveInfo *structs.LiveInfo) string {
+	teams := make([]structs.Team, 0, len(liveInfo.Teams))
+	for _, team := range liveInfo.Teams {
+		teams = append(teams, team)
+	}
+
+	slices.SortFunc(teams, func(a, b structs.Team) int {
+		return b.MatchPoints - a.MatchPoints
+	})
+
+	lines := []string{}
+	for _, team := range teams {
+		lines = append(lines, fmt.Sprintf("**%s** %d", StripFormatting(team.Name), team.MatchPoints))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Orders the players by their best time in time attack, otherwise by their points
+func rankPlayers(liveInfo *structs.LiveInfo) []structs.PlayerRound {
+	players := []structs.PlayerRound{}
+	for _, player := range liveInfo.Players {
+		if liveInfo.Type == "timeattack" && player.BestTime <= 0 {
+			continue
+		}
+		players = append(players, player)
+	}
+
+	slices.SortFunc(players, func(a, b structs.PlayerRound) int {
+		if liveInfo.Type == "timeattack" {
+			return a.BestTime - b.BestTime
+		}
+		if a.MatchPoints != b.MatchPoints {
+			return b.MatchPoints - a.MatchPoints
+		}
+		return a.Rank - b.Rank
+	})
+
+	return players
+}
+
+func formatPlayerResult(player structs.PlayerRound, liveInfo *structs.LiveInfo) string {
+	name := StripFormatting(player.Name)
+	if name == "" {
+		name = player.Login
+	}
+
+	if liveInfo.Type == "timeattack" {
+		return fmt.Sprintf("%s `%s`", name, FormatTime(player.BestTime))
+	}
+	return fmt.Sprintf("%s `%d pts`", name, player.MatchPoints)
+}
@@ -0,0 +1,153 @@
+package lib
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+// Returns the field of the embed with the given name
+func embedField(t *testing.T, embed structs.DiscordEmbed, name string) string {
+	t.Helper()
+
+	for _, field := range embed.Fields {
+		if field.Name == name {
+			return field.Value
+		}
+	}
+	t.Fatalf("embed has no %s field, fields = %+v", name, embed.Fields)
+	return ""
+}
+
+func TestFormatMatchResultTimeAttack(t *testing.T) {
+	config := structs.DiscordConfig{
+		Username:     "Results",
+		ThumbnailUrl: "https://maps.example/{uid}/thumbnail.jpg",
+	}
+	liveInfo := &structs.LiveInfo{
+		Mode: "Trackmania/TM_TimeAttack_Online.Script.txt",
+		Type: "timeattack",
+		Players: map[string]structs.PlayerRound{
+			"a": {Login: "a", Name: "$f00Alpha", BestTime: 45123},
+			"b": {Login: "b", Name: "Bravo", BestTime: 44000},
+			"c": {Login: "c", Name: "Charlie", BestTime: 46500},
+			"d": {Login: "d", Name: "", BestTime: 47000},
+			"e": {Login: "e", Name: "Echo", BestTime: 0},
+		},
+	}
+
+	payload := FormatMatchResult(config, "$oMy $$Server", "MapUid123", "$i$0f0Spring 01", liveInfo)
+
+	if payload.Username != "Results" {
+		t.Errorf("username = %q, want %q", payload.Username, "Results")
+	}
+	if len(payload.Embeds) != 1 {
+		t.Fatalf("embeds = %d, want 1", len(payload.Embeds))
+	}
+	embed := payload.Embeds[0]
+
+	if embed.Title != "Match finished on My $Server" {
+		t.Errorf("title = %q", embed.Title)
+	}
+	if embed.Thumbnail == nil || embed.Thumbnail.Url != "https://maps.example/MapUid123/thumbnail.jpg" {
+		t.Errorf("thumbnail = %+v, want the map uid in the url", embed.Thumbnail)
+	}
+	if embed.Footer == nil || embed.Footer.Text != liveInfo.Mode {
+		t.Errorf("footer = %+v, want the mode", embed.Footer)
+	}
+	if got := embedField(t, embed, "Map"); got != "Spring 01" {
+		t.Errorf("map = %q, want %q", got, "Spring 01")
+	}
+
+	wantPodium := "🥇 Bravo `0:44.000`\n🥈 Alpha `0:45.123`\n🥉 Charlie `0:46.500`"
+	if got := embedField(t, embed, "Podium"); got != wantPodium {
+		t.Errorf("podium = %q, want %q", got, wantPodium)
+	}
+
+	// Players without a time are not ranked, players without a name are listed by login
+	if got := embedField(t, embed, "Ranking"); got != "4. d `0:47.000`" {
+		t.Errorf("ranking = %q", got)
+	}
+
+	for _, field := range embed.Fields {
+		if field.Name == "Teams" {
+			t.Errorf("unexpected teams field %q", field.Value)
+		}
+	}
+}
+
+func TestFormatMatchResultTeams(t *testing.T) {
+	liveInfo := &structs.LiveInfo{
+		Type: "teams",
+		Teams: map[int]structs.Team{
+			0: {Id: 0, Name: "$00fBlue", MatchPoints: 3},
+			1: {Id: 1, Name: "$f00Red", MatchPoints: 5},
+		},
+		Players: map[string]structs.PlayerRound{
+			"a": {Login: "a", Name: "Alpha", MatchPoints: 10, Rank: 2},
+			"b": {Login: "b", Name: "Bravo", MatchPoints: 10, Rank: 1},
+			"c": {Login: "c", Name: "Charlie", MatchPoints: 12, Rank: 3},
+		},
+	}
+
+	payload := FormatMatchResult(structs.DiscordConfig{}, "Server", "", "", liveInfo)
+	embed := payload.Embeds[0]
+
+	if got := embedField(t, embed, "Teams"); got != "**Red** 5\n**Blue** 3" {
+		t.Errorf("teams = %q", got)
+	}
+
+	// Equal points are ordered by rank
+	wantPodium := "🥇 Charlie `12 pts`\n🥈 Bravo `10 pts`\n🥉 Alpha `10 pts`"
+	if got := embedField(t, embed, "Podium"); got != wantPodium {
+		t.Errorf("podium = %q, want %q", got, wantPodium)
+	}
+
+	if embed.Thumbnail != nil {
+		t.Errorf("thumbnail = %+v, want none without a thumbnail url", embed.Thumbnail)
+	}
+	if embed.Footer != nil {
+		t.Errorf("footer = %+v, want none without a mode", embed.Footer)
+	}
+}
+
+func TestPostMatchResult(t *testing.T) {
+	var received structs.DiscordWebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if strings.Contains(string(body), `"footer":{"text":""}`) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	liveInfo := &structs.LiveInfo{
+		Type:    "rounds",
+		Players: map[string]structs.PlayerRound{"a": {Login: "a", Name: "Alpha", MatchPoints: 7}},
+	}
+	body, err := json.Marshal(FormatMatchResult(structs.DiscordConfig{Username: "Bot"}, "Server", "", "", liveInfo))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := PostWebhook(server.URL, body, nil, 0); err != nil {
+		t.Fatalf("PostWebhook() error = %v", err)
+	}
+	if received.Username != "Bot" || len(received.Embeds) != 1 {
+		t.Fatalf("received = %+v", received)
+	}
+	if got := embedField(t, received.Embeds[0], "Podium"); got != "🥇 Alpha `7 pts`" {
+		t.Errorf("podium = %q", got)
+	}
+}
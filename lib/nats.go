@@ -0,0 +1,54 @@
+package lib
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// NatsSink publishes the events on NATS subjects like <prefix>.<serverUuid>.<topic>.<event>
+type NatsSink struct {
+	conn   *nats.Conn
+	prefix string
+}
+
+func NewNatsSink(url string, prefix string) (*NatsSink, error) {
+	conn, err := nats.Connect(url,
+		nats.Name("GbxConnector"),
+		nats.MaxReconnects(-1),
+		// Keep connecting in the background when NATS is not reachable yet
+		nats.RetryOnFailedConnect(true),
+		nats.ConnectHandler(func(conn *nats.Conn) {
+			zap.L().Info("Connected to NATS", zap.String("url", conn.ConnectedUrl()))
+		}),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			zap.L().Warn("Disconnected from NATS", zap.Error(err))
+		}),
+		nats.ReconnectHandler(func(conn *nats.Conn) {
+			zap.L().Info("Reconnected to NATS", zap.String("url", conn.ConnectedUrl()))
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefix == "" {
+		prefix = "gbx"
+	}
+
+	return &NatsSink{
+		conn:   conn,
+		prefix: prefix,
+	}, nil
+}
+
+func (s *NatsSink) Publish(serverUuid string, topic string, event string, payload json.RawMessage) error {
+	subject := strings.Join([]string{s.prefix, serverUuid, topic, event}, ".")
+	return s.conn.Publish(subject, payload)
+}
+
+func (s *NatsSink) Close() error {
+	return s.conn.Drain()
+}
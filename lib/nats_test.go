@@ -0,0 +1,102 @@
+package lib
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+)
+
+func subscribeSync(t *testing.T, url string, subject string) *nats.Subscription {
+	t.Helper()
+
+	conn, err := nats.Connect(url)
+	if err != nil {
+		t.Fatalf("nats.Connect() error = %v", err)
+	}
+	t.Cleanup(conn.Close)
+
+	sub, err := conn.SubscribeSync(subject)
+	if err != nil {
+		t.Fatalf("SubscribeSync() error = %v", err)
+	}
+	if err := conn.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	return sub
+}
+
+func TestNatsSinkPublish(t *testing.T) {
+	server := natsserver.RunRandClientPortServer()
+	defer server.Shutdown()
+
+	sink, err := NewNatsSink(server.ClientURL(), "")
+	if err != nil {
+		t.Fatalf("NewNatsSink() error = %v", err)
+	}
+	defer sink.Close()
+
+	sub := subscribeSync(t, server.ClientURL(), "gbx.>")
+
+	payload := []byte(`{"login":"player"}`)
+	if err := sink.Publish("server-uuid", "players", "connect", payload); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	msg, err := sub.NextMsg(2 * time.Second)
+	if err != nil {
+		t.Fatalf("NextMsg() error = %v", err)
+	}
+	if msg.Subject != "gbx.server-uuid.players.connect" {
+		t.Errorf("subject = %q, want %q", msg.Subject, "gbx.server-uuid.players.connect")
+	}
+	if string(msg.Data) != string(payload) {
+		t.Errorf("data = %s, want %s", msg.Data, payload)
+	}
+}
+
+func TestNatsSinkConnectsWhenBrokerStartsLater(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	url := fmt.Sprintf("nats://127.0.0.1:%d", port)
+	sink, err := NewNatsSink(url, "events")
+	if err != nil {
+		t.Fatalf("NewNatsSink() error = %v, want the sink to retry in the background", err)
+	}
+	defer sink.Close()
+
+	opts := natsserver.DefaultTestOptions
+	opts.Port = port
+	server := natsserver.RunServer(&opts)
+	defer server.Shutdown()
+
+	sub := subscribeSync(t, url, "events.>")
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !sink.conn.IsConnected() {
+		if time.Now().After(deadline) {
+			t.Fatal("sink did not connect to the broker")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if err := sink.Publish("server-uuid", "servers", "delete", []byte(`"server-uuid"`)); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	msg, err := sub.NextMsg(2 * time.Second)
+	if err != nil {
+		t.Fatalf("NextMsg() error = %v", err)
+	}
+	if msg.Subject != "events.server-uuid.servers.delete" {
+		t.Errorf("subject = %q, want %q", msg.Subject, "events.server-uuid.servers.delete")
+	}
+}
@@ -0,0 +1,38 @@
+package lib
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Sliding window rate limiter keyed by an arbitrary string
+type RateLimiter struct {
+	mu     sync.Mutex
+	events map[string][]time.Time
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		events: make(map[string][]time.Time),
+	}
+}
+
+// Registers an event for the key and reports whether it is within the limit
+func (rl *RateLimiter) Allow(key string, limit int, interval time.Duration) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	events := slices.DeleteFunc(rl.events[key], func(t time.Time) bool {
+		return now.Sub(t) > interval
+	})
+
+	if len(events) >= limit {
+		rl.events[key] = events
+		return false
+	}
+
+	rl.events[key] = append(events, now)
+	return true
+}
@@ -0,0 +1,149 @@
+package lib
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	TimeType       = reflect.TypeOf(time.Time{})
+	RawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// JSONField is a struct field as encoding/json encodes it
+type JSONField struct {
+	Name      string
+	Type      reflect.Type
+	OmitEmpty bool
+}
+
+// Returns the fields as encoding/json encodes them, embedded structs are flattened
+func JSONFields(t reflect.Type) []JSONField {
+	fields := []JSONField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" || (!f.IsExported() && !f.Anonymous) {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			fields = append(fields, JSONFields(f.Type)...)
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		fields = append(fields, JSONField{
+			Name:      name,
+			Type:      f.Type,
+			OmitEmpty: strings.Contains(options, "omitempty"),
+		})
+	}
+	return fields
+}
+
+// Whether the zero value of the type is encoded as null
+func IsNullable(t reflect.Type) bool {
+	if t == RawMessageType {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+// Named struct types get their own definition, the other types are inlined
+func IsSchemaDefinition(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.Name() != "" && t != TimeType
+}
+
+// SchemaGenerator builds the JSON Schema of Go types, named structs are added to Defs and referenced
+type SchemaGenerator struct {
+	RefPrefix string // e.g. #/$defs/
+	Defs      map[string]any
+}
+
+func NewSchemaGenerator(refPrefix string) *SchemaGenerator {
+	return &SchemaGenerator{
+		RefPrefix: refPrefix,
+		Defs:      make(map[string]any),
+	}
+}
+
+func (g *SchemaGenerator) Nullable(schema map[string]any, nullable bool) map[string]any {
+	if !nullable {
+		return schema
+	}
+	return map[string]any{"anyOf": []any{schema, map[string]any{"type": "null"}}}
+}
+
+func (g *SchemaGenerator) Schema(t reflect.Type) map[string]any {
+	if t == TimeType {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+	if t == RawMessageType {
+		return map[string]any{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.Schema(t.Elem())
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.Schema(t.Elem())}
+	case reflect.Map:
+		schema := map[string]any{"type": "object", "additionalProperties": g.Schema(t.Elem())}
+		if t.Key().Kind() != reflect.String {
+			schema["propertyNames"] = map[string]any{"pattern": "^-?[0-9]+$"}
+		}
+		return schema
+	case reflect.Struct:
+		if IsSchemaDefinition(t) {
+			if _, ok := g.Defs[t.Name()]; !ok {
+				g.Defs[t.Name()] = nil // Reserve the name for recursive types
+				g.Defs[t.Name()] = g.Object(t)
+			}
+			return map[string]any{"$ref": g.RefPrefix + t.Name()}
+		}
+		return g.Object(t)
+	}
+
+	// Interfaces can hold anything
+	return map[string]any{}
+}
+
+func (g *SchemaGenerator) Object(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	required := []string{}
+
+	for _, f := range JSONFields(t) {
+		properties[f.Name] = g.Nullable(g.Schema(f.Type), !f.OmitEmpty && IsNullable(f.Type))
+		if !f.OmitEmpty {
+			required = append(required, f.Name)
+		}
+	}
+
+	return map[string]any{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
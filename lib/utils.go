@@ -18,7 +18,10 @@ func ConvertCallbackData(data any, target any) error {
 
 func IsDockerInternalIP(ip string, networkRange string) bool {
 	parsedIP := net.ParseIP(ip)
-	_, dockerNet, _ := net.ParseCIDR(networkRange)
+	_, dockerNet, err := net.ParseCIDR(networkRange)
+	if err != nil {
+		return false
+	}
 	return dockerNet.Contains(parsedIP)
 }
 
@@ -35,3 +38,18 @@ func FilterServersByUuid(allServers []structs.ServerResponse, subscriptionSet ma
 	}
 	return filtered
 }
+
+// Formats a race time in milliseconds as m:ss.mmm
+func FormatTime(ms int) string {
+	sign := ""
+	if ms < 0 {
+		sign = "-"
+		ms = -ms
+	}
+
+	minutes := ms / 60000
+	seconds := (ms % 60000) / 1000
+	millis := ms % 1000
+
+	return fmt.Sprintf("%s%d:%02d.%03d", sign, minutes, seconds, millis)
+}
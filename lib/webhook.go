@@ -0,0 +1,74 @@
+package lib
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// First delay between retries, doubled after every attempt
+var webhookBackoff = time.Second
+
+// Signs the body with HMAC-SHA256, sent as "sha256=<hex>"
+func SignPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Posts the body to the url, retrying with an exponential backoff starting at one second.
+// A client error other than 408 or 429 is permanent and not retried.
+// Returns the number of attempts and the last error.
+func PostWebhook(url string, body []byte, headers map[string]string, retries int) (int, error) {
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(webhookBackoff << (attempt - 1))
+		}
+
+		var retry bool
+		if retry, err = postWebhook(url, body, headers); err == nil || !retry {
+			return attempt + 1, err
+		}
+	}
+
+	return retries + 1, err
+}
+
+// Posts the body once, returns whether a failed delivery may be retried
+func postWebhook(url string, body []byte, headers map[string]string) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return isRetryableStatus(resp.StatusCode), fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return false, nil
+}
+
+func isRetryableStatus(statusCode int) bool {
+	if statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return statusCode < 400 || statusCode >= 500
+}
@@ -0,0 +1,81 @@
+package lib
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func init() {
+	webhookBackoff = time.Millisecond
+}
+
+func TestPostWebhookSignsBody(t *testing.T) {
+	body := []byte(`{"event":"playerConnect"}`)
+	signature := SignPayload("secret", body)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ := io.ReadAll(r.Body)
+		if SignPayload("secret", received) != r.Header.Get("X-Gbx-Signature") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+	}))
+	defer server.Close()
+
+	attempts, err := PostWebhook(server.URL, body, map[string]string{"X-Gbx-Signature": signature}, 3)
+	if err != nil {
+		t.Fatalf("PostWebhook() error = %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestPostWebhookRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		succeedAfter int32
+		retries      int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{"server error recovers", http.StatusInternalServerError, 2, 3, 3, false},
+		{"server error exhausts retries", http.StatusBadGateway, 0, 2, 3, true},
+		{"too many requests is retried", http.StatusTooManyRequests, 1, 3, 2, false},
+		{"request timeout is retried", http.StatusRequestTimeout, 1, 3, 2, false},
+		{"client error is permanent", http.StatusNotFound, 0, 3, 1, true},
+		{"unauthorized is permanent", http.StatusUnauthorized, 0, 3, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if n := calls.Add(1); tt.succeedAfter == 0 || n <= tt.succeedAfter {
+					w.WriteHeader(tt.status)
+				}
+			}))
+			defer server.Close()
+
+			attempts, err := PostWebhook(server.URL, []byte(`{}`), nil, tt.retries)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PostWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if int(calls.Load()) != tt.wantAttempts {
+				t.Errorf("server received %d requests, want %d", calls.Load(), tt.wantAttempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package listeners
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/lib"
+	"go.uber.org/zap"
+)
+
+// How long a forwarded message is remembered to recognize it coming back
+const bridgeEchoWindow = 10 * time.Second
+
+var bridgeLimiter = lib.NewRateLimiter()
+
+var (
+	bridgedMessages   = make(map[string]map[string]time.Time) // Forwarded messages by target server ID
+	bridgedMessagesMu sync.Mutex
+)
+
+// Forwards a routed chat message to the other servers of the bridge groups the server is in
+func (cl *ChatListener) forwardToBridges(login string, nickName string, text string) {
+	forwarded := make(map[string]bool)
+
+	for _, group := range config.AppEnv.Bridges {
+		if !slices.Contains(group.Servers, cl.Server.Uuid) {
+			continue
+		}
+
+		if group.RateLimit > 0 {
+			interval := time.Duration(max(group.RateInterval, 1)) * time.Second
+			if !bridgeLimiter.Allow(group.Id, group.RateLimit, interval) {
+				zap.L().Debug("Bridge rate limit reached", zap.String("bridge_id", group.Id), zap.String("server_uuid", cl.Server.Uuid))
+				continue
+			}
+		}
+
+		message := group.FormatMessage(cl.Server.Name, login, nickName, text)
+
+		for _, serverUuid := range group.Servers {
+			// Never send back to the source, and only once to servers in multiple groups
+			if serverUuid == cl.Server.Uuid || forwarded[serverUuid] {
+				continue
+			}
+
+			target := config.AppEnv.Servers.GetByUuid(serverUuid)
+			if target == nil || target.Client == nil || !target.Client.IsConnected {
+				continue
+			}
+
+			forwarded[serverUuid] = true
+			rememberBridgedMessage(serverUuid, message)
+
+			if err := handlers.SendChatMessage(target, message, nil); err != nil {
+				zap.L().Error("Failed to forward bridged message", zap.String("bridge_id", group.Id), zap.String("server_uuid", serverUuid), zap.Error(err))
+			}
+		}
+	}
+}
+
+func rememberBridgedMessage(serverUuid string, message string) {
+	bridgedMessagesMu.Lock()
+	defer bridgedMessagesMu.Unlock()
+
+	messages, ok := bridgedMessages[serverUuid]
+	if !ok {
+		messages = make(map[string]time.Time)
+		bridgedMessages[serverUuid] = messages
+	}
+
+	for m, t := range messages {
+		if time.Since(t) > bridgeEchoWindow {
+			delete(messages, m)
+		}
+	}
+
+	messages[message] = time.Now()
+}
+
+// Reports whether the message was recently forwarded to the server, so it is not bridged again
+func isBridgedMessage(serverUuid string, message string) bool {
+	bridgedMessagesMu.Lock()
+	defer bridgedMessagesMu.Unlock()
+
+	t, ok := bridgedMessages[serverUuid][message]
+	return ok && time.Since(t) <= bridgeEchoWindow
+}
@@ -1,6 +1,12 @@
 package listeners
 
 import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/lib"
 	"github.com/MRegterschot/GbxConnector/structs"
 	"github.com/MRegterschot/GbxRemoteGo/events"
 	"github.com/MRegterschot/GbxRemoteGo/gbxclient"
@@ -8,10 +14,20 @@ import (
 
 type ChatListener struct {
 	Server *structs.Server
+
+	mu           sync.Mutex
+	cooldowns    map[string]time.Time
+	skipVotes    map[string]bool
+	floodLimiter *lib.RateLimiter // Chat messages per login
 }
 
 func AddChatListeners(server *structs.Server) *ChatListener {
-	cl := &ChatListener{Server: server}
+	cl := &ChatListener{
+		Server:       server,
+		cooldowns:    make(map[string]time.Time),
+		skipVotes:    make(map[string]bool),
+		floodLimiter: lib.NewRateLimiter(),
+	}
 	server.Client.OnPlayerChat = append(server.Client.OnPlayerChat, gbxclient.GbxCallbackStruct[events.PlayerChatEventArgs]{
 		Key:  "ChatListener",
 		Call: cl.onPlayerChat,
@@ -26,89 +42,113 @@ func AddChatListeners(server *structs.Server) *ChatListener {
 		Key:  "ChatListener",
 		Call: cl.onPlayerDisconnect,
 	})
+
+	server.Client.OnBeginMap = append(server.Client.OnBeginMap, gbxclient.GbxCallbackStruct[events.MapEventArgs]{
+		Key:  "ChatListener",
+		Call: cl.onBeginMap,
+	})
 	return cl
 }
 
 func (cl *ChatListener) onPlayerChat(playerChatEvent events.PlayerChatEventArgs) {
-	// If manual routing is not enabled, we don't need to handle the chat message
-	if !cl.Server.Info.Chat.ManualRouting {
+	// If the login is empty, we don't need to handle the chat message
+	if playerChatEvent.Login == "" {
 		return
 	}
 
-	// If the login is empty, we don't need to handle the chat message
-	if playerChatEvent.Login == "" {
+	// Messages forwarded by a chat bridge must not be handled again
+	if isBridgedMessage(cl.Server.Uuid, playerChatEvent.Text) {
 		return
 	}
 
-	// If the message starts with a slash, we don't need to handle the chat message
-	if len(playerChatEvent.Text) > 0 && playerChatEvent.Text[0] == '/' {
+	// Commands are handled regardless of manual routing, but not for muted players
+	if strings.HasPrefix(playerChatEvent.Text, "/") {
+		if cl.rejectMuted(playerChatEvent.Login, playerChatEvent.Text) {
+			return
+		}
+		cl.handleCommand(playerChatEvent.Login, playerChatEvent.Text)
 		return
 	}
 
-	if cl.Server.Info.Chat.MessageFormat == "" {
-		// If no override format is set, just send the raw message to everyone
-		cl.Server.Client.ChatForwardToLogin(playerChatEvent.Text, playerChatEvent.Login, "")
+	// If manual routing is not enabled, the server already sent the message
+	if !cl.Server.Info.Chat.ManualRouting {
+		cl.broadcastPlayerMessage(playerChatEvent.Login, playerChatEvent.Text)
 		return
 	}
 
-	var player structs.PlayerInfo
-	for _, p := range cl.Server.Info.ActivePlayers {
-		if p.Login == playerChatEvent.Login {
-			player = p
-			break
-		}
+	text, ok := cl.moderate(playerChatEvent.Login, playerChatEvent.Text)
+	if !ok {
+		return
+	}
+
+	player := findActivePlayer(cl.Server, playerChatEvent.Login)
+
+	cl.broadcastPlayerMessage(playerChatEvent.Login, text)
+	cl.forwardToBridges(player.Login, player.NickName, text)
+
+	if cl.Server.Info.Chat.MessageFormat == "" {
+		// If no override format is set, just send the raw message to everyone
+		cl.Server.Client.ChatForwardToLogin(text, playerChatEvent.Login, "")
+		return
 	}
 
 	// Format the message using the override format
 	message := cl.Server.Info.Chat.MessageFormat.FormatMessage(
 		player.Login,
 		player.NickName,
-		playerChatEvent.Text,
+		text,
 	)
 
 	cl.Server.Client.ChatSendServerMessage(message)
 }
 
 func (cl *ChatListener) onPlayerConnect(playerConnectEvent events.PlayerConnectEventArgs) {
-	if cl.Server.Info.Chat.ConnectMessage == "" {
+	messages := withFallback(cl.Server, cl.Server.Info.Chat.ConnectMessages, cl.Server.Info.Chat.ConnectMessage)
+	if len(messages) == 0 {
 		return
 	}
 
-	var player structs.PlayerInfo
-	for _, p := range cl.Server.Info.ActivePlayers {
-		if p.Login == playerConnectEvent.Login {
-			player = p
-			break
-		}
-	}
+	// The language is detected by the players listener, which runs before this one
+	player := findActivePlayer(cl.Server, playerConnectEvent.Login)
 
-	message := cl.Server.Info.Chat.ConnectMessage.FormatMessage(
-		player.Login,
-		player.NickName,
-		"",
-	)
-
-	cl.Server.Client.ChatSendServerMessage(message)
+	sendLocalizedMessage(cl.Server, messages, nil, map[string]string{
+		"login":    player.Login,
+		"nickName": player.NickName,
+		"message":  "",
+	})
 }
 
 func (cl *ChatListener) onPlayerDisconnect(playerDisconnectEvent events.PlayerDisconnectEventArgs) {
-	if cl.Server.Info.Chat.DisconnectMessage == "" {
-		return
+	// A player that left no longer counts towards the skip vote, the remaining votes can be enough now
+	if votes, needed := cl.removeSkipVote(playerDisconnectEvent.Login); votes > 0 && votes >= needed {
+		cl.passSkipVote()
 	}
 
-	var player structs.PlayerInfo
-	for _, p := range cl.Server.Info.ActivePlayers {
-		if p.Login == playerDisconnectEvent.Login {
-			player = p
-			break
-		}
+	messages := withFallback(cl.Server, cl.Server.Info.Chat.DisconnectMessages, cl.Server.Info.Chat.DisconnectMessage)
+	if len(messages) == 0 {
+		return
 	}
 
-	message := cl.Server.Info.Chat.DisconnectMessage.FormatMessage(
-		player.Login,
-		player.NickName,
-		"",
-	)
+	player := findActivePlayer(cl.Server, playerDisconnectEvent.Login)
 
-	cl.Server.Client.ChatSendServerMessage(message)
+	sendLocalizedMessage(cl.Server, messages, nil, map[string]string{
+		"login":    player.Login,
+		"nickName": player.NickName,
+		"message":  "",
+	})
+}
+
+// Broadcasts a player message to the chat socket
+func (cl *ChatListener) broadcastPlayerMessage(login string, text string) {
+	player := findActivePlayer(cl.Server, login)
+	handlers.BroadcastChat(cl.Server.Uuid, structs.ChatMessage{
+		Type:     structs.ChatMessageTypePlayer,
+		Login:    login,
+		NickName: player.NickName,
+		Text:     text,
+	})
+}
+
+func (cl *ChatListener) onBeginMap(_ events.MapEventArgs) {
+	cl.resetSkipVotes()
 }
@@ -0,0 +1,313 @@
+package listeners
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"go.uber.org/zap"
+)
+
+type CommandHandler func(ctx *CommandContext)
+
+type ChatCommand struct {
+	Name        string
+	Description string
+	Usage       string
+	Admin       bool
+	MinArgs     int
+	Cooldown    time.Duration
+	Handler     CommandHandler
+}
+
+type CommandContext struct {
+	Server  *structs.Server
+	Login   string
+	Args    []string
+	Command ChatCommand
+
+	chat *ChatListener
+}
+
+var (
+	commands   = make(map[string]ChatCommand)
+	commandsMu sync.RWMutex
+)
+
+func init() {
+	RegisterCommand(ChatCommand{
+		Name:        "help",
+		Description: "List the available commands",
+		Handler:     helpCommand,
+	})
+	RegisterCommand(ChatCommand{
+		Name:        "skip",
+		Description: "Vote to skip the current map",
+		Cooldown:    10 * time.Second,
+		Handler:     skipCommand,
+	})
+	RegisterCommand(ChatCommand{
+		Name:        "time",
+		Description: "Show the current server time",
+		Handler:     timeCommand,
+	})
+	RegisterCommand(ChatCommand{
+		Name:        "pb",
+		Description: "Show your personal best on the current map",
+		Handler:     pbCommand,
+	})
+	RegisterCommand(ChatCommand{
+		Name:        "admin",
+		Description: "Admin commands",
+		Usage:       "admin kick <login>",
+		Admin:       true,
+		MinArgs:     1,
+		Handler:     adminCommand,
+	})
+}
+
+// RegisterCommand registers a chat command on all servers, replacing any command with the same name
+func RegisterCommand(cmd ChatCommand) {
+	commandsMu.Lock()
+	defer commandsMu.Unlock()
+
+	commands[strings.ToLower(cmd.Name)] = cmd
+}
+
+// Reply sends a message to the player that issued the command
+func (ctx *CommandContext) Reply(message string) {
+	if err := ctx.Server.Client.ChatSendServerMessageToLogin(message, ctx.Login); err != nil {
+		zap.L().Error("Failed to send command reply", zap.String("server_uuid", ctx.Server.Uuid), zap.String("login", ctx.Login), zap.Error(err))
+	}
+}
+
+// ReplyMessage sends a system message to the player that issued the command in their language
+func (ctx *CommandContext) ReplyMessage(key string, vars map[string]string) {
+	sendSystemMessage(ctx.Server, key, []string{ctx.Login}, vars)
+}
+
+func (cl *ChatListener) handleCommand(login string, text string) {
+	fields := strings.Fields(strings.TrimPrefix(text, "/"))
+	if len(fields) == 0 {
+		return
+	}
+
+	// Unknown commands are left alone, they might be handled by the server or another controller
+	cmd, ok := cl.findCommand(strings.ToLower(fields[0]))
+	if !ok {
+		return
+	}
+
+	ctx := &CommandContext{
+		Server:  cl.Server,
+		Login:   login,
+		Args:    fields[1:],
+		Command: cmd,
+		chat:    cl,
+	}
+
+	isAdmin := cl.Server.IsAdmin(login)
+	if cmd.Admin && !isAdmin {
+		ctx.ReplyMessage(structs.MessageCommandNoPermission, nil)
+		return
+	}
+
+	if len(ctx.Args) < cmd.MinArgs {
+		ctx.ReplyMessage(structs.MessageCommandUsage, map[string]string{"usage": cmd.Usage})
+		return
+	}
+
+	if !isAdmin {
+		if remaining := cl.useCooldown(login, cmd); remaining > 0 {
+			ctx.ReplyMessage(structs.MessageCommandCooldown, map[string]string{
+				"seconds": strconv.Itoa(int(remaining.Seconds()) + 1),
+				"command": cmd.Name,
+			})
+			return
+		}
+	}
+
+	zap.L().Debug("Executing chat command", zap.String("server_uuid", cl.Server.Uuid), zap.String("login", login), zap.String("command", cmd.Name))
+	cmd.Handler(ctx)
+}
+
+// Looks up a registered command, falling back to the commands from the chat config
+func (cl *ChatListener) findCommand(name string) (ChatCommand, bool) {
+	commandsMu.RLock()
+	cmd, ok := commands[name]
+	commandsMu.RUnlock()
+	if ok {
+		return cmd, true
+	}
+
+	for _, custom := range cl.Server.Info.Chat.Commands {
+		if strings.EqualFold(custom.Name, name) {
+			return customChatCommand(custom), true
+		}
+	}
+
+	return ChatCommand{}, false
+}
+
+func customChatCommand(custom structs.CustomCommand) ChatCommand {
+	return ChatCommand{
+		Name:        strings.ToLower(custom.Name),
+		Description: custom.Description,
+		Admin:       custom.Admin,
+		Cooldown:    time.Duration(custom.Cooldown) * time.Second,
+		Handler: func(ctx *CommandContext) {
+			player := findActivePlayer(ctx.Server, ctx.Login)
+			ctx.Reply(custom.Response.FormatMessage(player.Login, player.NickName, strings.Join(ctx.Args, " ")))
+		},
+	}
+}
+
+// Returns the remaining cooldown, or starts a new cooldown if there is none
+func (cl *ChatListener) useCooldown(login string, cmd ChatCommand) time.Duration {
+	if cmd.Cooldown <= 0 {
+		return 0
+	}
+
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	key := cmd.Name + ":" + login
+	if until, ok := cl.cooldowns[key]; ok && time.Now().Before(until) {
+		return time.Until(until)
+	}
+
+	cl.cooldowns[key] = time.Now().Add(cmd.Cooldown)
+	return 0
+}
+
+// Adds a skip vote and returns the vote count and the number of votes needed
+func (cl *ChatListener) addSkipVote(login string) (int, int) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	cl.skipVotes[login] = true
+	return len(cl.skipVotes), cl.skipVotesNeeded("")
+}
+
+// Removes the skip vote of a player that left, returns the vote count and the number of votes needed without them
+func (cl *ChatListener) removeSkipVote(login string) (int, int) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	delete(cl.skipVotes, login)
+	return len(cl.skipVotes), cl.skipVotesNeeded(login)
+}
+
+// A majority of the players that are not spectating, the player that left is not counted
+func (cl *ChatListener) skipVotesNeeded(leftLogin string) int {
+	players := 0
+	for _, p := range cl.Server.Info.ActivePlayers {
+		if p.Login != leftLogin && !structs.DecodeSpectatorStatus(p.SpectatorStatus).IsSpectator {
+			players++
+		}
+	}
+	return players/2 + 1
+}
+
+// Skips the map when the vote passed
+func (cl *ChatListener) passSkipVote() {
+	cl.resetSkipVotes()
+	sendSystemMessage(cl.Server, structs.MessageCommandSkipPassed, nil, nil)
+	if err := cl.Server.Client.NextMap(); err != nil {
+		zap.L().Error("Failed to skip map", zap.String("server_uuid", cl.Server.Uuid), zap.Error(err))
+	}
+}
+
+func (cl *ChatListener) resetSkipVotes() {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	cl.skipVotes = make(map[string]bool)
+}
+
+func findActivePlayer(server *structs.Server, login string) structs.PlayerInfo {
+	for _, p := range server.Info.ActivePlayers {
+		if p.Login == login {
+			return p
+		}
+	}
+	return structs.PlayerInfo{Login: login}
+}
+
+func helpCommand(ctx *CommandContext) {
+	isAdmin := ctx.Server.IsAdmin(ctx.Login)
+	names := []string{}
+
+	commandsMu.RLock()
+	for name, cmd := range commands {
+		if !cmd.Admin || isAdmin {
+			names = append(names, "/"+name)
+		}
+	}
+	commandsMu.RUnlock()
+
+	for _, custom := range ctx.Server.Info.Chat.Commands {
+		name := "/" + strings.ToLower(custom.Name)
+		if (!custom.Admin || isAdmin) && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+	ctx.ReplyMessage(structs.MessageCommandHelp, map[string]string{"commands": strings.Join(names, ", ")})
+}
+
+func skipCommand(ctx *CommandContext) {
+	votes, needed := ctx.chat.addSkipVote(ctx.Login)
+	player := findActivePlayer(ctx.Server, ctx.Login)
+
+	if votes < needed {
+		sendSystemMessage(ctx.Server, structs.MessageCommandSkipVote, nil, map[string]string{
+			"nickName": player.NickName,
+			"votes":    strconv.Itoa(votes),
+			"needed":   strconv.Itoa(needed),
+		})
+		return
+	}
+
+	ctx.chat.passSkipVote()
+}
+
+func timeCommand(ctx *CommandContext) {
+	ctx.ReplyMessage(structs.MessageCommandTime, map[string]string{"time": time.Now().Format("15:04:05")})
+}
+
+func pbCommand(ctx *CommandContext) {
+	player, ok := ctx.Server.Info.LiveInfo.Players[ctx.Login]
+	if !ok || player.BestTime <= 0 {
+		ctx.ReplyMessage(structs.MessageCommandNoPb, nil)
+		return
+	}
+
+	ctx.ReplyMessage(structs.MessageCommandPb, map[string]string{"time": lib.FormatTime(player.BestTime)})
+}
+
+func adminCommand(ctx *CommandContext) {
+	switch strings.ToLower(ctx.Args[0]) {
+	case "kick":
+		if len(ctx.Args) < 2 {
+			ctx.ReplyMessage(structs.MessageCommandUsage, map[string]string{"usage": "admin kick <login>"})
+			return
+		}
+
+		if err := ctx.Server.Client.Kick(ctx.Args[1], ""); err != nil {
+			zap.L().Error("Failed to kick player", zap.String("server_uuid", ctx.Server.Uuid), zap.String("login", ctx.Args[1]), zap.Error(err))
+			ctx.ReplyMessage(structs.MessageCommandKickFailed, map[string]string{"login": ctx.Args[1]})
+			return
+		}
+
+		zap.L().Info("Player kicked", zap.String("server_uuid", ctx.Server.Uuid), zap.String("login", ctx.Args[1]), zap.String("admin", ctx.Login))
+		ctx.ReplyMessage(structs.MessageCommandKicked, map[string]string{"login": ctx.Args[1]})
+	default:
+		ctx.ReplyMessage(structs.MessageCommandUsage, map[string]string{"usage": ctx.Command.Usage})
+	}
+}
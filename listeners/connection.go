@@ -31,6 +31,7 @@ func onDisconnect(server *structs.Server) {
 	go func() {
 		for range onDisconnectChan {
 			zap.L().Info("Server disconnected", zap.String("server_uuid", server.Uuid))
+			EndSessions(server)
 			handlers.BroadcastServers(config.AppEnv.Servers.ToServerResponses())
 		}
 	}()
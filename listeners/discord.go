@@ -0,0 +1,36 @@
+package listeners
+
+import (
+	"encoding/json"
+
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"go.uber.org/zap"
+)
+
+const discordRetries = 3
+
+// Posts the result of the match to the Discord webhook of the server
+func postMatchResult(server *structs.Server) {
+	if server.Discord == nil || server.Discord.WebhookUrl == "" {
+		return
+	}
+
+	mapInfo, err := server.Client.GetCurrentMapInfo()
+	if err != nil {
+		zap.L().Error("Failed to get current map info", zap.String("server_uuid", server.Uuid), zap.Error(err))
+	}
+
+	payload := lib.FormatMatchResult(*server.Discord, server.Name, mapInfo.UId, mapInfo.Name, server.Info.LiveInfo)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		zap.L().Error("Failed to encode match result", zap.String("server_uuid", server.Uuid), zap.Error(err))
+		return
+	}
+
+	go func(url string) {
+		if _, err := lib.PostWebhook(url, body, nil, discordRetries); err != nil {
+			zap.L().Error("Failed to post match result to Discord", zap.String("server_uuid", server.Uuid), zap.Error(err))
+		}
+	}(server.Discord.WebhookUrl)
+}
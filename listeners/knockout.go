@@ -0,0 +1,127 @@
+package listeners
+
+import (
+	"slices"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+func isKnockout(liveInfo *structs.LiveInfo) bool {
+	return liveInfo.Type == "knockout" && liveInfo.Knockout != nil
+}
+
+// Starts a new knockout round, the players in the active round are the ones still in the match
+func (ll *LiveListener) startKnockoutRound() {
+	liveInfo := ll.Server.Info.LiveInfo
+	if !isKnockout(liveInfo) || liveInfo.IsWarmUp {
+		return
+	}
+
+	ko := liveInfo.Knockout
+	ko.Round++
+	ko.PlayersRemaining = len(liveInfo.ActiveRound.Players)
+	ko.EliminationsPerRound = knockoutEliminations(liveInfo.PointsRepartition, ko.PlayersRemaining)
+	ko.DangerZone = []string{}
+
+	ll.broadcastKnockout()
+}
+
+// Records the eliminated players, the worst ranked player gets the lowest placement
+func (ll *LiveListener) eliminateKnockoutPlayers(accountIds []string) {
+	liveInfo := ll.Server.Info.LiveInfo
+	if !isKnockout(liveInfo) {
+		return
+	}
+
+	ko := liveInfo.Knockout
+	ranking := rankActiveRound(liveInfo.ActiveRound)
+
+	eliminated := []structs.PlayerRound{}
+	for _, player := range liveInfo.Players {
+		if slices.Contains(accountIds, player.AccountId) {
+			eliminated = append(eliminated, player)
+		}
+	}
+
+	slices.SortFunc(eliminated, func(a, b structs.PlayerRound) int {
+		return rankingIndex(ranking, b.Login) - rankingIndex(ranking, a.Login)
+	})
+
+	for _, player := range eliminated {
+		ko.Eliminations = append(ko.Eliminations, structs.KnockoutElimination{
+			Login:     player.Login,
+			AccountId: player.AccountId,
+			Name:      player.Name,
+			Round:     ko.Round,
+			Placement: ko.PlayersRemaining,
+		})
+		ko.PlayersRemaining = max(ko.PlayersRemaining-1, 0)
+	}
+
+	ko.DangerZone = []string{}
+
+	// The last player standing wins the match
+	if ko.PlayersRemaining == 1 {
+		for _, pw := range ranking {
+			if !liveInfo.Players[pw.Login].Eliminated {
+				ko.Winner = pw.Login
+				break
+			}
+		}
+	}
+
+	ll.broadcastKnockout()
+}
+
+// Updates the players that would be eliminated if the round ended now, broadcasts on change
+func (ll *LiveListener) updateKnockoutDangerZone() {
+	liveInfo := ll.Server.Info.LiveInfo
+	if !isKnockout(liveInfo) || liveInfo.IsWarmUp {
+		return
+	}
+
+	ko := liveInfo.Knockout
+	ranking := rankActiveRound(liveInfo.ActiveRound)
+
+	dangerZone := []string{}
+	for i := max(len(ranking)-ko.EliminationsPerRound, 0); i < len(ranking); i++ {
+		dangerZone = append(dangerZone, ranking[i].Login)
+	}
+
+	if slices.Equal(dangerZone, ko.DangerZone) {
+		return
+	}
+
+	ko.DangerZone = dangerZone
+	ll.broadcastKnockout()
+}
+
+func (ll *LiveListener) broadcastKnockout() {
+	handlers.BroadcastLive(ll.Server.Uuid, map[string]*structs.KnockoutInfo{
+		"knockout": ll.Server.Info.LiveInfo.Knockout,
+	})
+}
+
+// Number of players eliminated per round, one more for each rank threshold that is exceeded
+func knockoutEliminations(thresholds []int, playersRemaining int) int {
+	eliminations := 1
+	for _, threshold := range thresholds {
+		if playersRemaining > threshold {
+			eliminations++
+		}
+	}
+
+	return min(eliminations, max(playersRemaining-1, 0))
+}
+
+// Returns the position of the player in the ranking, players not in the round are ranked last
+func rankingIndex(ranking []structs.PlayerWaypoint, login string) int {
+	index := slices.IndexFunc(ranking, func(pw structs.PlayerWaypoint) bool {
+		return pw.Login == login
+	})
+	if index < 0 {
+		return len(ranking)
+	}
+	return index
+}
@@ -1,12 +1,11 @@
 package listeners
 
 import (
+	"slices"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/MRegterschot/GbxConnector/handlers"
-	"github.com/MRegterschot/GbxConnector/lib"
 	"github.com/MRegterschot/GbxConnector/structs"
 	"github.com/MRegterschot/GbxRemoteGo/events"
 	"github.com/MRegterschot/GbxRemoteGo/gbxclient"
@@ -100,6 +99,8 @@ func AddLiveListeners(server *structs.Server) *LiveListener {
 		Call: ll.onElimination,
 	})
 
+	server.Client.AddScriptCallback("Maniaplanet.EndMatch_Start", "gbxconnector", ll.onEndMatch)
+
 	return ll
 }
 
@@ -109,13 +110,30 @@ func (ll *LiveListener) onPlayerFinish(playerFinishEvent events.PlayerWayPointEv
 	pw.Time = playerFinishEvent.RaceTime
 	pw.HasFinished = true
 	pw.Checkpoint = playerFinishEvent.CheckpointInRace + 1
+	pw.CheckpointTimes = addCheckpointTime(pw.CheckpointTimes, playerFinishEvent)
+
+	lapFinished := false
+	if ll.Server.Info.LiveInfo.Type == "laps" {
+		lapFinished = ll.updateLap(&pw, playerFinishEvent)
+	}
 
 	ll.Server.Info.LiveInfo.ActiveRound.Players[playerFinishEvent.Login] = pw
+	changes := ll.updatePositions()
+
+	if lapFinished {
+		handlers.BroadcastLive(ll.Server.Uuid, map[string]structs.ActiveRound{
+			"lapFinish": ll.Server.Info.LiveInfo.ActiveRound,
+		})
+	}
 
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]structs.ActiveRound{
 		"finish": ll.Server.Info.LiveInfo.ActiveRound,
 	})
 
+	ll.broadcastSplit(pw)
+	ll.broadcastPositionChanges(changes)
+	ll.updateKnockoutDangerZone()
+
 	if ll.Server.Info.LiveInfo.Type != "timeattack" {
 		return
 	}
@@ -126,7 +144,9 @@ func (ll *LiveListener) onPlayerFinish(playerFinishEvent events.PlayerWayPointEv
 	}
 
 	p.BestTime = playerFinishEvent.RaceTime
+	p.BestCheckpoints = slices.Clone(pw.CheckpointTimes)
 	ll.Server.Info.LiveInfo.Players[playerFinishEvent.Login] = p
+	ll.refreshServerRecord()
 
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]*structs.LiveInfo{
 		"personalBest": ll.Server.Info.LiveInfo,
@@ -140,12 +160,59 @@ func (ll *LiveListener) onPlayerCheckpoint(playerCheckpointEvent events.PlayerWa
 	pw.Checkpoint = playerCheckpointEvent.CheckpointInRace + 1
 	pw.HasFinished = false
 	pw.HasGivenUp = false
+	pw.CheckpointTimes = addCheckpointTime(pw.CheckpointTimes, playerCheckpointEvent)
+
+	lapFinished := false
+	if ll.Server.Info.LiveInfo.Type == "laps" {
+		lapFinished = ll.updateLap(&pw, playerCheckpointEvent)
+	}
 
 	ll.Server.Info.LiveInfo.ActiveRound.Players[playerCheckpointEvent.Login] = pw
+	changes := ll.updatePositions()
+
+	if lapFinished {
+		handlers.BroadcastLive(ll.Server.Uuid, map[string]structs.ActiveRound{
+			"lapFinish": ll.Server.Info.LiveInfo.ActiveRound,
+		})
+	}
 
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]structs.ActiveRound{
 		"checkpoint": ll.Server.Info.LiveInfo.ActiveRound,
 	})
+
+	ll.broadcastSplit(pw)
+	ll.broadcastPositionChanges(changes)
+	ll.updateKnockoutDangerZone()
+}
+
+// Stores the race time of the waypoint, a respawn on an earlier checkpoint overwrites the later times
+func addCheckpointTime(checkpointTimes []int, wayPointEvent events.PlayerWayPointEventArgs) []int {
+	return append(checkpointTimes[:min(wayPointEvent.CheckpointInRace, len(checkpointTimes))], wayPointEvent.RaceTime)
+}
+
+// Tracks the lap of a player in laps mode, returns true when the waypoint finished a lap
+func (ll *LiveListener) updateLap(pw *structs.PlayerWaypoint, wayPointEvent events.PlayerWayPointEventArgs) bool {
+	// Checkpoints are counted per lap, not for the whole race
+	pw.Checkpoint = wayPointEvent.CheckpointInLap + 1
+	pw.Lap = len(pw.LapTimes) + 1
+
+	if !wayPointEvent.IsEndLap {
+		return false
+	}
+
+	pw.LapTimes = append(pw.LapTimes, wayPointEvent.LapTime)
+	if pw.BestLap == 0 || wayPointEvent.LapTime < pw.BestLap {
+		pw.BestLap = wayPointEvent.LapTime
+	}
+
+	p := ll.Server.Info.LiveInfo.Players[wayPointEvent.Login]
+	if p.BestLapTime == 0 || wayPointEvent.LapTime < p.BestLapTime {
+		p.BestLapTime = wayPointEvent.LapTime
+		p.BestLapCheckpoints = wayPointEvent.CurrentLapCheckpoints
+		ll.Server.Info.LiveInfo.Players[wayPointEvent.Login] = p
+	}
+
+	return true
 }
 
 func (ll *LiveListener) onStartRound(_ struct{}) {
@@ -178,19 +245,29 @@ func (ll *LiveListener) onStartRound(_ struct{}) {
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]structs.ActiveRound{
 		"beginRound": ll.Server.Info.LiveInfo.ActiveRound,
 	})
+
+	ll.startKnockoutRound()
 }
 
 func (ll *LiveListener) onEndRound(endRoundEvent events.ScoresEventArgs) {
 	if endRoundEvent.UseTeams {
 		for _, team := range endRoundEvent.Teams {
 			t := ll.Server.Info.LiveInfo.Teams[team.ID]
-			if ll.Server.Info.LiveInfo.Type == "tmwc" || ll.Server.Info.LiveInfo.Type == "tmwt" {
+			if isTeamMatch(ll.Server.Info.LiveInfo) {
 				t.RoundPoints = team.MapPoints
 			} else {
 				t.RoundPoints = team.RoundPoints
 			}
 			t.MatchPoints = team.MatchPoints
 			ll.Server.Info.LiveInfo.Teams[team.ID] = t
+
+			if ll.Server.Info.LiveInfo.Series != nil {
+				setSeriesTeam(ll.Server.Info.LiveInfo.Series, team.ID, team.Name, team.MapPoints, team.MatchPoints)
+			}
+		}
+
+		if ll.Server.Info.LiveInfo.Series != nil {
+			updateSeriesFlags(ll.Server.Info.LiveInfo)
 		}
 	}
 
@@ -204,50 +281,90 @@ func (ll *LiveListener) onEndRound(endRoundEvent events.ScoresEventArgs) {
 		p.BestCheckpoints = player.BestRaceCheckpoints
 		p.PrevTime = player.PrevRaceTime
 		p.PrevCheckpoints = player.PrevRaceCheckpoints
+		p.BestLapTime = player.BestLapTime
+		p.BestLapCheckpoints = player.BestLapCheckpoints
 		ll.Server.Info.LiveInfo.Players[player.Login] = p
 	}
 
-	ll.Server.Client.TriggerModeScriptEventArray("Maniaplanet.Pause.GetStatus", []string{"gbxconnector"})
+	ll.refreshServerRecord()
 
-	time.Sleep(300 * time.Millisecond) // Wait a bit for callbacks to be set
+	// The round end is broadcast once the pause status is known
+	syncPauseStatus(ll.Server, func() {
+		handlers.BroadcastLive(ll.Server.Uuid, map[string]*structs.LiveInfo{
+			"endRound": ll.Server.Info.LiveInfo,
+		})
 
-	handlers.BroadcastLive(ll.Server.Uuid, map[string]*structs.LiveInfo{
-		"endRound": ll.Server.Info.LiveInfo,
+		handlers.DispatchWebhook(ll.Server.Uuid, structs.WebhookEventRoundEnd, ll.Server.Info.LiveInfo)
+
+		if ll.Server.Info.LiveInfo.Series != nil {
+			ll.broadcastSeries()
+		}
 	})
 }
 
 func (ll *LiveListener) onBeginMap(beginMapEvent events.MapEventArgs) {
 	ll.Server.Info.LiveInfo.CurrentMap = beginMapEvent.Map.Uid
+	ll.Server.Info.LiveInfo.ServerRecord = nil
+
+	// Best times are per map, they must not be compared with the times on the new map
+	for login, p := range ll.Server.Info.LiveInfo.Players {
+		p.BestTime = 0
+		p.BestCheckpoints = []int{}
+		p.BestLapTime = 0
+		p.BestLapCheckpoints = nil
+		ll.Server.Info.LiveInfo.Players[login] = p
+	}
+
+	// The number of laps can differ per map
+	setLapsLimit(ll.Server)
 
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]string{
 		"beginMap": beginMapEvent.Map.Uid,
 	})
+
+	if ll.Server.Info.LiveInfo.Series != nil {
+		startSeriesMap(ll.Server.Info.LiveInfo)
+		ll.broadcastSeries()
+	}
 }
 
 func (ll *LiveListener) onEndMap(endMapEvent events.MapEventArgs) {
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]string{
 		"endMap": endMapEvent.Map.Uid,
 	})
+
+	if ll.Server.Info.LiveInfo.Series != nil {
+		endSeriesMap(ll.Server.Info.LiveInfo, endMapEvent.Map.Uid)
+		ll.broadcastSeries()
+	}
 }
 
 func (ll *LiveListener) onBeginMatch(_ struct{}) {
-	SyncLiveInfo(ll.Server)
-
-	time.Sleep(300 * time.Millisecond) // Wait a bit for callbacks to be set
-
-	handlers.BroadcastLive(ll.Server.Uuid, map[string]*structs.LiveInfo{
-		"beginMatch": ll.Server.Info.LiveInfo,
+	SyncLiveInfo(ll.Server, func() {
+		handlers.BroadcastLive(ll.Server.Uuid, map[string]*structs.LiveInfo{
+			"beginMatch": ll.Server.Info.LiveInfo,
+		})
 	})
 }
 
+func (ll *LiveListener) onEndMatch(_ any) {
+	handlers.DispatchWebhook(ll.Server.Uuid, structs.WebhookEventMatchEnd, ll.Server.Info.LiveInfo)
+	postMatchResult(ll.Server)
+}
+
 func (ll *LiveListener) onPlayerGiveUp(playerGiveUpEvent events.PlayerGiveUpEventArgs) {
 	r := ll.Server.Info.LiveInfo.ActiveRound.Players[playerGiveUpEvent.Login]
 	r.HasGivenUp = true
 	ll.Server.Info.LiveInfo.ActiveRound.Players[playerGiveUpEvent.Login] = r
+	changes := ll.updatePositions()
 
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]structs.ActiveRound{
 		"giveUp": ll.Server.Info.LiveInfo.ActiveRound,
 	})
+
+	ll.broadcastPositionChanges(changes)
+
+	ll.updateKnockoutDangerZone()
 }
 
 func (ll *LiveListener) onWarmUpStart(_ struct{}) {
@@ -304,6 +421,16 @@ func (ll *LiveListener) onPlayerInfoChanged(playerInfoChangedEvent events.Player
 	p.Name = playerInfoChangedEvent.PlayerInfo.NickName
 	ll.Server.Info.LiveInfo.Players[playerInfoChangedEvent.PlayerInfo.Login] = p
 
+	// Spectators are not counted as team members
+	teamId := p.Team
+	if structs.DecodeSpectatorStatus(playerInfoChangedEvent.PlayerInfo.SpectatorStatus).IsSpectator {
+		teamId = -1
+	}
+
+	if setTeamMember(ll.Server.Info.LiveInfo, playerInfoChangedEvent.PlayerInfo.Login, teamId) {
+		ll.broadcastTeams()
+	}
+
 	spectator := playerInfoChangedEvent.PlayerInfo.SpectatorStatus != 0
 	if spectator {
 		delete(ll.Server.Info.LiveInfo.ActiveRound.Players, playerInfoChangedEvent.PlayerInfo.Login)
@@ -360,6 +487,10 @@ func (ll *LiveListener) onPlayerDisconnect(playerDisconnectEvent events.PlayerDi
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]structs.ActiveRound{
 		"playerDisconnect": ll.Server.Info.LiveInfo.ActiveRound,
 	})
+
+	if setTeamMember(ll.Server.Info.LiveInfo, playerDisconnectEvent.Login, -1) {
+		ll.broadcastTeams()
+	}
 }
 
 func (ll *LiveListener) onEcho(echoEvent events.EchoEventArgs) {
@@ -381,17 +512,18 @@ func (ll *LiveListener) onElimination(eliminationEvent events.EliminationEventAr
 		}
 	}
 
+	ll.eliminateKnockoutPlayers(eliminationEvent.AccountIds)
+
 	handlers.BroadcastLive(ll.Server.Uuid, map[string]*structs.LiveInfo{
 		"elimination": ll.Server.Info.LiveInfo,
 	})
 }
 
-func SyncLiveInfo(server *structs.Server) {
+// SyncLiveInfo syncs the live info with the server. The script responses are applied when their
+// callbacks arrive, done is called once the last one is applied and may be nil.
+func SyncLiveInfo(server *structs.Server, done func()) {
 	// Set warmup status
-	server.Client.AddScriptCallback("Trackmania.WarmUp.Status", "server", func(event any) {
-		onWarmUpStatus(event, server)
-	})
-	server.Client.TriggerModeScriptEventArray("Trackmania.WarmUp.GetStatus", []string{"gbxconnector"})
+	syncWarmUpStatus(server)
 
 	// Set the current game mode
 	mode, err := server.Client.GetScriptName()
@@ -419,10 +551,27 @@ func SyncLiveInfo(server *structs.Server) {
 		server.Info.LiveInfo.Type = "teams"
 	case strings.Contains(modeLower, "knockout"):
 		server.Info.LiveInfo.Type = "knockout"
+	case strings.Contains(modeLower, "laps"):
+		server.Info.LiveInfo.Type = "laps"
 	default:
 		server.Info.LiveInfo.Type = "rounds"
 	}
 
+	// Start a new knockout model for the match
+	server.Info.LiveInfo.Knockout = nil
+	if server.Info.LiveInfo.Type == "knockout" {
+		server.Info.LiveInfo.Knockout = &structs.KnockoutInfo{
+			Eliminations: []structs.KnockoutElimination{},
+			DangerZone:   []string{},
+		}
+	}
+
+	// Start a new series for team matches
+	server.Info.LiveInfo.Series = nil
+	if isTeamMatch(server.Info.LiveInfo) {
+		server.Info.LiveInfo.Series = newSeries()
+	}
+
 	mapInfo, err := server.Client.GetCurrentMapInfo()
 	if err != nil {
 		zap.L().Error("Failed to get current map info", zap.String("server_uuid", server.Uuid), zap.Error(err))
@@ -430,8 +579,15 @@ func SyncLiveInfo(server *structs.Server) {
 
 	server.Info.LiveInfo.CurrentMap = mapInfo.UId
 
+	// The laps limit is set again from the script settings or the map
+	server.Info.LiveInfo.LapsLimit = nil
 	setScriptSettings(server)
 
+	// Use the laps of the map when the script doesn't force the number of laps
+	if server.Info.LiveInfo.Type == "laps" && server.Info.LiveInfo.LapsLimit == nil && mapInfo.NbLaps > 0 {
+		server.Info.LiveInfo.LapsLimit = &mapInfo.NbLaps
+	}
+
 	// Set map list
 	mapList, err := server.Client.GetMapList(1000, 0)
 	if err != nil {
@@ -443,43 +599,36 @@ func SyncLiveInfo(server *structs.Server) {
 		server.Info.LiveInfo.Maps[i] = m.UId
 	}
 
-	server.Client.AddScriptCallback("Trackmania.Scores", "server", func(event any) {
-		onScores(event, server)
-	})
-	server.Client.TriggerModeScriptEventArray("Trackmania.GetScores", []string{"gbxconnector"})
+	// Set scores
+	syncScores(server)
 
-	// Set pause status
-	server.Client.AddScriptCallback("Maniaplanet.Pause.Status", "server", func(event any) {
-		onPauseStatus(event, server)
-	})
-	server.Client.TriggerModeScriptEventArray("Maniaplanet.Pause.GetStatus", []string{"gbxconnector"})
+	// Set pause status, the script answers in order so this is the last response
+	syncPauseStatus(server, done)
 }
 
-func onWarmUpStatus(event any, server *structs.Server) {
-	var status structs.WarmUpStatus
-	if err := lib.ConvertCallbackData(event, &status); err != nil {
-		zap.L().Error("Failed to get callback data", zap.Error(err))
-		return
-	}
-
-	if status.ResponseId != "gbxconnector" {
-		return
-	}
+func syncWarmUpStatus(server *structs.Server) {
+	triggerAndApply(server, "Trackmania.WarmUp.GetStatus", "Trackmania.WarmUp.Status", func(status structs.WarmUpStatus, err error) {
+		if err != nil {
+			zap.L().Error("Failed to get warmup status", zap.String("server_uuid", server.Uuid), zap.Error(err))
+			return
+		}
 
-	server.Info.LiveInfo.IsWarmUp = status.Active
+		server.Info.LiveInfo.IsWarmUp = status.Active
+	})
 }
 
-func onScores(event any, server *structs.Server) {
-	var scores structs.Scores
-	if err := lib.ConvertCallbackData(event, &scores); err != nil {
-		zap.L().Error("Failed to get callback data", zap.Error(err))
-		return
-	}
+func syncScores(server *structs.Server) {
+	triggerAndApply(server, "Trackmania.GetScores", "Trackmania.Scores", func(scores structs.Scores, err error) {
+		if err != nil {
+			zap.L().Error("Failed to get scores", zap.String("server_uuid", server.Uuid), zap.Error(err))
+			return
+		}
 
-	if scores.ResponseId != "gbxconnector" {
-		return
-	}
+		onScores(scores, server)
+	})
+}
 
+func onScores(scores structs.Scores, server *structs.Server) {
 	if scores.UseTeams {
 		server.Info.LiveInfo.Teams = make(map[int]structs.Team)
 		for _, team := range scores.Teams {
@@ -488,29 +637,50 @@ func onScores(event any, server *structs.Server) {
 				Name:        team.Name,
 				RoundPoints: team.RoundPoints,
 				MatchPoints: team.MatchPoints,
+				Members:     []string{},
+			}
+
+			if server.Info.LiveInfo.Series != nil {
+				setSeriesTeam(server.Info.LiveInfo.Series, team.Id, team.Name, team.MapPoints, team.MatchPoints)
 			}
 		}
+
+		// When joining a series halfway, the current map follows the maps already won
+		if series := server.Info.LiveInfo.Series; series != nil {
+			if series.MapNumber == 0 {
+				series.MapNumber = 1
+				for _, t := range series.Teams {
+					series.MapNumber += t.MapsWon
+				}
+			}
+			updateSeriesFlags(server.Info.LiveInfo)
+		}
 	}
 
 	server.Info.LiveInfo.Players = make(map[string]structs.PlayerRound)
 	for _, player := range scores.Players {
 		server.Info.LiveInfo.Players[player.Login] = structs.PlayerRound{
-			Login:           player.Login,
-			AccountId:       player.AccountId,
-			Name:            player.Name,
-			Team:            player.Team,
-			Rank:            player.Rank,
-			Finalist:        isFinalist(player.MatchPoints, server.Info.LiveInfo.PointsLimit),
-			Winner:          isWinner(player.MatchPoints, server.Info.LiveInfo.PointsLimit),
-			RoundPoints:     player.RoundPoints,
-			MatchPoints:     player.MatchPoints,
-			BestTime:        player.BestRaceTime,
-			BestCheckpoints: player.BestCheckpoints,
-			PrevTime:        player.PrevRaceTime,
-			PrevCheckpoints: player.PrevCheckpoints,
+			Login:              player.Login,
+			AccountId:          player.AccountId,
+			Name:               player.Name,
+			Team:               player.Team,
+			Rank:               player.Rank,
+			Finalist:           isFinalist(player.MatchPoints, server.Info.LiveInfo.PointsLimit),
+			Winner:             isWinner(player.MatchPoints, server.Info.LiveInfo.PointsLimit),
+			RoundPoints:        player.RoundPoints,
+			MatchPoints:        player.MatchPoints,
+			BestTime:           player.BestRaceTime,
+			BestCheckpoints:    player.BestCheckpoints,
+			PrevTime:           player.PrevRaceTime,
+			PrevCheckpoints:    player.PrevCheckpoints,
+			BestLapTime:        player.BestLapTime,
+			BestLapCheckpoints: player.BestLapCheckpoints,
 		}
 	}
 
+	server.Info.LiveInfo.ServerRecord = nil
+	updateServerRecord(server.Info.LiveInfo)
+
 	playerList, err := server.Client.GetPlayerList(1000, 0)
 	if err != nil {
 		zap.L().Error("Failed to get player list", zap.String("server_uuid", server.Uuid), zap.Error(err))
@@ -536,21 +706,57 @@ func onScores(event any, server *structs.Server) {
 			server.Info.LiveInfo.ActiveRound.Players[player.Login] = playerWaypoint
 		}
 	}
+
+	if scores.UseTeams {
+		players := make([]structs.PlayerInfo, len(playerList))
+		for i, player := range playerList {
+			players[i] = structs.ToPlayerInfo(player)
+		}
+		syncTeamRoster(server, players)
+	}
+}
+
+// Syncs the pause status, done is called once the status is applied or could not be retrieved
+func syncPauseStatus(server *structs.Server, done func()) {
+	triggerAndApply(server, "Maniaplanet.Pause.GetStatus", "Maniaplanet.Pause.Status", func(status structs.Pause, err error) {
+		if err != nil {
+			zap.L().Error("Failed to get pause status", zap.String("server_uuid", server.Uuid), zap.Error(err))
+		} else {
+			server.Info.LiveInfo.PauseAvailable = status.Available
+			server.Info.LiveInfo.IsPaused = status.Active
+		}
+
+		if done != nil {
+			done()
+		}
+	})
 }
 
-func onPauseStatus(event any, server *structs.Server) {
-	var status structs.Pause
-	if err := lib.ConvertCallbackData(event, &status); err != nil {
-		zap.L().Error("Failed to get callback data", zap.Error(err))
+// Sets the laps limit to the number of laps forced by the script, or else the laps of the current map in a laps race
+func setLapsLimit(server *structs.Server) {
+	server.Info.LiveInfo.LapsLimit = nil
+
+	scriptSettings, err := server.Client.GetModeScriptSettings()
+	if err != nil {
+		zap.L().Error("Failed to get script settings", zap.String("server_uuid", server.Uuid), zap.Error(err))
+	} else if lapsLimit, ok := scriptSettings["S_ForceLapsNb"].(int); ok && lapsLimit > 0 {
+		server.Info.LiveInfo.LapsLimit = &lapsLimit
+		return
+	}
+
+	if server.Info.LiveInfo.Type != "laps" {
 		return
 	}
 
-	if status.ResponseId != "gbxconnector" {
+	mapInfo, err := server.Client.GetCurrentMapInfo()
+	if err != nil {
+		zap.L().Error("Failed to get current map info", zap.String("server_uuid", server.Uuid), zap.Error(err))
 		return
 	}
 
-	server.Info.LiveInfo.PauseAvailable = status.Available
-	server.Info.LiveInfo.IsPaused = status.Active
+	if mapInfo.NbLaps > 0 {
+		server.Info.LiveInfo.LapsLimit = &mapInfo.NbLaps
+	}
 }
 
 func setScriptSettings(server *structs.Server) {
@@ -563,7 +769,7 @@ func setScriptSettings(server *structs.Server) {
 	plVar := "S_PointsLimit"
 	mlVar := "S_MapsPerMatch"
 	prVar := "S_PointsRepartition"
-	if server.Info.LiveInfo.Type == "tmwc" || server.Info.LiveInfo.Type == "tmwt" {
+	if isTeamMatch(server.Info.LiveInfo) {
 		plVar = "S_MapPointsLimit"
 		mlVar = "S_MatchPointsLimit"
 	}
@@ -604,6 +810,14 @@ func setScriptSettings(server *structs.Server) {
 		server.Info.LiveInfo.NbWinners = &nbWinners
 	}
 
+	// Set forced number of laps
+	lapsLimit, ok := scriptSettings["S_ForceLapsNb"].(int)
+	if !ok {
+		zap.L().Debug("ForceLapsNb not found in script settings", zap.String("server_uuid", server.Uuid))
+	} else if lapsLimit > 0 {
+		server.Info.LiveInfo.LapsLimit = &lapsLimit
+	}
+
 	// Set points repartition
 	pointsRepartition, ok := scriptSettings[prVar].(string)
 	if !ok {
@@ -43,4 +43,6 @@ func (ml *MapListener) onStartMap(data any) {
 	handlers.BroadcastMap(ml.Server.Uuid, map[string]string{
 		"startMap": event.Map.UId,
 	})
+
+	handlers.DispatchWebhook(ml.Server.Uuid, structs.WebhookEventMapStart, event.Map)
 }
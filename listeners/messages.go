@@ -0,0 +1,91 @@
+package listeners
+
+import (
+	"maps"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"go.uber.org/zap"
+)
+
+// Returns the variants of a system message with the overrides from the chat config applied
+func systemMessage(server *structs.Server, key string) structs.LocalizedMessage {
+	messages := make(structs.LocalizedMessage)
+	maps.Copy(messages, structs.SystemMessages[key])
+	maps.Copy(messages, server.Info.Chat.Messages[key])
+	return messages
+}
+
+// Returns the variants with the single format used for the default language when it has no variant
+func withFallback(server *structs.Server, messages structs.LocalizedMessage, format structs.MessageFormat) structs.LocalizedMessage {
+	result := make(structs.LocalizedMessage)
+	maps.Copy(result, messages)
+
+	if _, ok := result[server.DefaultLanguage()]; !ok && format != "" {
+		result[server.DefaultLanguage()] = format
+	}
+	return result
+}
+
+// Sends a system message to the given logins in their own language
+func sendSystemMessage(server *structs.Server, key string, logins []string, vars map[string]string) {
+	sendLocalizedMessage(server, systemMessage(server, key), logins, vars)
+}
+
+// Sends a localized message to the given logins, or to all players when logins is nil.
+// Players are grouped by the resulting text, so each variant is only sent once.
+func sendLocalizedMessage(server *structs.Server, messages structs.LocalizedMessage, logins []string, vars map[string]string) {
+	broadcast := logins == nil
+	if broadcast {
+		for _, p := range server.Info.ActivePlayers {
+			logins = append(logins, p.Login)
+		}
+	}
+
+	groups := make(map[string][]string)
+	texts := []string{}
+	for _, login := range logins {
+		format, ok := messages.Resolve(server.PlayerLanguage(login), server.DefaultLanguage())
+		if !ok {
+			continue
+		}
+
+		text := format.Format(vars)
+		if _, ok := groups[text]; !ok {
+			texts = append(texts, text)
+		}
+		groups[text] = append(groups[text], login)
+	}
+
+	// Everyone gets the same text, so just send it to the whole server
+	if broadcast && len(texts) == 1 {
+		groups[texts[0]] = nil
+	}
+
+	for _, text := range texts {
+		if err := handlers.SendChatMessage(server, text, groups[text]); err != nil {
+			zap.L().Error("Failed to send chat message", zap.String("server_uuid", server.Uuid), zap.Error(err))
+			continue
+		}
+
+		// A message to everyone that is split by language is not private, it is broadcast with its recipients
+		if broadcast && groups[text] != nil {
+			handlers.BroadcastChat(server.Uuid, structs.ChatMessage{
+				Type: structs.ChatMessageTypeServer,
+				Text: text,
+				To:   groups[text],
+			})
+		}
+	}
+}
+
+// Gets the language of the player from the detailed player info
+func detectPlayerLanguage(server *structs.Server, login string) {
+	playerInfo, err := server.Client.GetDetailedPlayerInfo(login)
+	if err != nil {
+		zap.L().Error("Failed to get detailed player info", zap.String("server_uuid", server.Uuid), zap.String("login", login), zap.Error(err))
+		return
+	}
+
+	server.Info.Languages.SetDetected(login, playerInfo.Language)
+}
@@ -0,0 +1,141 @@
+package listeners
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+// Links with a scheme or www., or bare domains on common top level domains so chat like "gg.wp" is not blocked
+var linkRegex = regexp.MustCompile(`(?i)(?:https?://|www\.)[^\s$\]]+|\b[a-z0-9-]+(?:\.[a-z0-9-]+)*\.(?:com|net|org|info|io|gg|tv|me|co|xyz|dev|app|ly|eu|de|nl|be|fr|uk|ru)\b(?:/[^\s$\]]*)?`)
+
+// Logs and notifies the message of a muted player, returns whether the player is muted
+func (cl *ChatListener) rejectMuted(login string, text string) bool {
+	mute, ok := cl.Server.Info.Moderation.GetMute(login)
+	if !ok {
+		return false
+	}
+
+	cl.Server.Info.Moderation.Log(structs.ModerationLogEntry{
+		Login:   login,
+		Action:  structs.ModerationActionMuted,
+		Message: text,
+	})
+	cl.notify(login, structs.MessageModerationMuted, map[string]string{
+		"duration": time.Until(mute.Until).Round(time.Second).String(),
+	})
+	handlers.ScheduleModerationSave()
+	return true
+}
+
+// Runs a chat message through the moderation pipeline.
+// Returns the (possibly masked) message and whether it may be sent.
+func (cl *ChatListener) moderate(login string, text string) (string, bool) {
+	config := cl.Server.Info.Chat.Moderation
+	moderation := cl.Server.Info.Moderation
+
+	if cl.rejectMuted(login, text) {
+		return "", false
+	}
+
+	if config.RateLimit > 0 {
+		interval := time.Duration(max(config.RateInterval, 1)) * time.Second
+		if !cl.floodLimiter.Allow(login, config.RateLimit, interval) {
+			moderation.Log(structs.ModerationLogEntry{
+				Login:   login,
+				Action:  structs.ModerationActionFlood,
+				Message: text,
+			})
+
+			if config.FloodMuteDuration > 0 {
+				moderation.Mute(login, time.Duration(config.FloodMuteDuration)*time.Second, "Flooding", "")
+				cl.notify(login, structs.MessageModerationFloodMute, map[string]string{
+					"seconds": strconv.Itoa(config.FloodMuteDuration),
+				})
+				handlers.SaveModeration()
+			} else {
+				cl.notify(login, structs.MessageModerationFlood, nil)
+				handlers.ScheduleModerationSave()
+			}
+			return "", false
+		}
+	}
+
+	if config.BlockLinks {
+		if link := findBlockedLink(text, config.AllowedDomains); link != "" {
+			moderation.Log(structs.ModerationLogEntry{
+				Login:   login,
+				Action:  structs.ModerationActionLink,
+				Message: text,
+				Reason:  link,
+			})
+			cl.notify(login, structs.MessageModerationLink, nil)
+			handlers.ScheduleModerationSave()
+			return "", false
+		}
+	}
+
+	if masked := maskWords(text, config.WordFilter(), config.MaskCharacter); masked != text {
+		moderation.Log(structs.ModerationLogEntry{
+			Login:   login,
+			Action:  structs.ModerationActionMask,
+			Message: text,
+		})
+		text = masked
+		handlers.ScheduleModerationSave()
+	}
+
+	return text, true
+}
+
+// Sends a moderation notice to a single player in their language
+func (cl *ChatListener) notify(login string, key string, vars map[string]string) {
+	sendSystemMessage(cl.Server, key, []string{login}, vars)
+}
+
+// Returns the first link in the text that is not on an allowed domain
+func findBlockedLink(text string, allowedDomains []string) string {
+	for _, link := range linkRegex.FindAllString(text, -1) {
+		if !isAllowedLink(link, allowedDomains) {
+			return link
+		}
+	}
+	return ""
+}
+
+func isAllowedLink(link string, allowedDomains []string) bool {
+	host := strings.ToLower(link)
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexAny(host, "/:?#"); i >= 0 {
+		host = host[:i]
+	}
+
+	for _, domain := range allowedDomains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// Replaces the matches of the word filter in the text with the mask character
+func maskWords(text string, wordFilter *regexp.Regexp, maskCharacter string) string {
+	if wordFilter == nil {
+		return text
+	}
+
+	if maskCharacter == "" {
+		maskCharacter = "*"
+	}
+
+	return wordFilter.ReplaceAllStringFunc(text, func(match string) string {
+		return strings.Repeat(maskCharacter, len([]rune(match)))
+	})
+}
@@ -3,6 +3,7 @@ package listeners
 import (
 	"slices"
 
+	"github.com/MRegterschot/GbxConnector/config"
 	"github.com/MRegterschot/GbxConnector/handlers"
 	"github.com/MRegterschot/GbxConnector/structs"
 	"github.com/MRegterschot/GbxRemoteGo/events"
@@ -39,11 +40,16 @@ func (pl *PlayersListener) onPlayerConnect(playerConnectEvent events.PlayerConne
 		return
 	}
 
-	pl.Server.Info.ActivePlayers = append(pl.Server.Info.ActivePlayers, structs.ToPlayerInfo(playerInfo))
+	player := structs.ToPlayerInfo(playerInfo)
+	pl.Server.Info.ActivePlayers = append(pl.Server.Info.ActivePlayers, player)
+	detectPlayerLanguage(pl.Server, playerConnectEvent.Login)
+	config.AppEnv.Sessions.Start(pl.Server.Uuid, player.Login, player.NickName, player.IsSpectator)
 
 	handlers.BroadcastPlayers(pl.Server.Uuid, map[string]structs.PlayerInfo{
 		"connect": structs.ToPlayerInfo(playerInfo),
 	})
+
+	handlers.DispatchWebhook(pl.Server.Uuid, structs.WebhookEventPlayerConnect, player)
 }
 
 func (pl *PlayersListener) onPlayerDisconnect(playerDisconnectEvent events.PlayerDisconnectEventArgs) {
@@ -53,6 +59,8 @@ func (pl *PlayersListener) onPlayerDisconnect(playerDisconnectEvent events.Playe
 			break
 		}
 	}
+	pl.Server.Info.Languages.SetDetected(playerDisconnectEvent.Login, "")
+	endSession(pl.Server, playerDisconnectEvent.Login)
 
 	handlers.BroadcastPlayers(pl.Server.Uuid, map[string]string{
 		"disconnect": playerDisconnectEvent.Login,
@@ -73,6 +81,7 @@ func (pl *PlayersListener) onPlayerInfoChanged(playerInfoChangedEvent events.Pla
 				PlayerId:        playerInfoChangedEvent.PlayerInfo.PlayerId,
 				TeamId:          playerInfoChangedEvent.PlayerInfo.TeamId,
 				SpectatorStatus: playerInfoChangedEvent.PlayerInfo.SpectatorStatus,
+				SpectatorInfo:   structs.DecodeSpectatorStatus(playerInfoChangedEvent.PlayerInfo.SpectatorStatus),
 			}
 			pl.Server.Info.ActivePlayers[i] = playerInfo
 			break
@@ -83,6 +92,8 @@ func (pl *PlayersListener) onPlayerInfoChanged(playerInfoChangedEvent events.Pla
 		return
 	}
 
+	config.AppEnv.Sessions.Start(pl.Server.Uuid, playerInfo.Login, playerInfo.NickName, playerInfo.IsSpectator)
+
 	handlers.BroadcastPlayers(pl.Server.Uuid, map[string]structs.PlayerInfo{
 		"infoChanged": playerInfo,
 	})
@@ -107,7 +118,10 @@ func SyncPlayerList(server *structs.Server) {
 			continue
 		}
 
-		playerList = append(playerList, structs.ToPlayerInfo(player))
+		playerInfo := structs.ToPlayerInfo(player)
+		playerList = append(playerList, playerInfo)
+		detectPlayerLanguage(server, player.Login)
+		config.AppEnv.Sessions.Start(server.Uuid, playerInfo.Login, playerInfo.NickName, playerInfo.IsSpectator)
 	}
 
 	server.Info.ActivePlayers = playerList
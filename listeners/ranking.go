@@ -0,0 +1,101 @@
+package listeners
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+// Orders the players of the active round by their progress in the round
+func rankActiveRound(round structs.ActiveRound) []structs.PlayerWaypoint {
+	players := slices.Collect(maps.Values(round.Players))
+	slices.SortFunc(players, compareWaypoints)
+	return players
+}
+
+func compareWaypoints(a, b structs.PlayerWaypoint) int {
+	// Players that gave up are always last
+	if a.HasGivenUp != b.HasGivenUp {
+		if a.HasGivenUp {
+			return 1
+		}
+		return -1
+	}
+
+	if a.HasFinished != b.HasFinished {
+		if a.HasFinished {
+			return -1
+		}
+		return 1
+	}
+
+	// Further in the race is better, at the same checkpoint the first one to pass it is ahead
+	if a.Lap != b.Lap {
+		return b.Lap - a.Lap
+	}
+
+	if a.Checkpoint != b.Checkpoint {
+		return b.Checkpoint - a.Checkpoint
+	}
+
+	if a.Time != b.Time {
+		return a.Time - b.Time
+	}
+
+	return strings.Compare(a.Login, b.Login)
+}
+
+// Updates the position and gaps of every player in the active round.
+// Returns the players whose position changed since the previous update.
+func (ll *LiveListener) updatePositions() []structs.PositionChange {
+	round := ll.Server.Info.LiveInfo.ActiveRound
+	ranking := rankActiveRound(round)
+
+	changes := []structs.PositionChange{}
+	for i, pw := range ranking {
+		previous := pw.Position
+
+		pw.Position = i + 1
+		pw.GapToLeader = 0
+		pw.GapToAhead = 0
+		if i > 0 && !pw.HasGivenUp {
+			pw.GapToLeader = checkpointGap(pw, ranking[0])
+			pw.GapToAhead = checkpointGap(pw, ranking[i-1])
+		}
+
+		round.Players[pw.Login] = pw
+
+		if previous != 0 && previous != pw.Position {
+			changes = append(changes, structs.PositionChange{
+				Login:            pw.Login,
+				Position:         pw.Position,
+				PreviousPosition: previous,
+			})
+		}
+	}
+
+	return changes
+}
+
+// Time difference between two players at the last checkpoint the first player passed
+func checkpointGap(pw structs.PlayerWaypoint, ahead structs.PlayerWaypoint) int {
+	cp := len(pw.CheckpointTimes)
+	if cp == 0 || len(ahead.CheckpointTimes) < cp {
+		return 0
+	}
+
+	return pw.CheckpointTimes[cp-1] - ahead.CheckpointTimes[cp-1]
+}
+
+func (ll *LiveListener) broadcastPositionChanges(changes []structs.PositionChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	handlers.BroadcastLive(ll.Server.Uuid, map[string][]structs.PositionChange{
+		"positionChange": changes,
+	})
+}
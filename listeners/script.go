@@ -0,0 +1,249 @@
+package listeners
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultScriptTimeout = 5 * time.Second
+	syncScriptTimeout    = 2 * time.Second // Used while syncing the live info, keeps the events from waiting too long
+)
+
+type ScriptListener struct {
+	Server *structs.Server
+
+	mu         sync.Mutex
+	registered map[string]bool                  // Callbacks with a registered handler
+	pending    map[string]func(json.RawMessage) // Response handlers of the triggers by response id
+}
+
+var (
+	scriptListeners   = make(map[string]*ScriptListener) // Script listeners by server ID
+	scriptListenersMu sync.Mutex
+)
+
+func AddScriptListeners(server *structs.Server) *ScriptListener {
+	sl := &ScriptListener{
+		Server:     server,
+		registered: make(map[string]bool),
+		pending:    make(map[string]func(json.RawMessage)),
+	}
+
+	scriptListenersMu.Lock()
+	scriptListeners[server.Uuid] = sl
+	scriptListenersMu.Unlock()
+
+	SyncScriptCallbacks(server)
+
+	return sl
+}
+
+func getScriptListener(server *structs.Server) *ScriptListener {
+	scriptListenersMu.Lock()
+	defer scriptListenersMu.Unlock()
+
+	return scriptListeners[server.Uuid]
+}
+
+// SyncScriptCallbacks registers a handler for the allowlisted callbacks that don't have one yet.
+// Callbacks removed from the allowlist keep their handler but are no longer forwarded.
+func SyncScriptCallbacks(server *structs.Server) {
+	sl := getScriptListener(server)
+	if sl == nil {
+		return
+	}
+
+	for _, name := range server.ScriptCallbacks {
+		sl.register(name)
+	}
+}
+
+func (sl *ScriptListener) register(name string) {
+	sl.mu.Lock()
+	if sl.registered[name] {
+		sl.mu.Unlock()
+		return
+	}
+	sl.registered[name] = true
+	sl.mu.Unlock()
+
+	sl.Server.Client.AddScriptCallback(name, "script", func(event any) {
+		sl.onScriptCallback(name, event)
+	})
+}
+
+func (sl *ScriptListener) onScriptCallback(name string, event any) {
+	data := rawCallbackData(event)
+
+	// Hand the callback to the trigger with the same response id
+	var response struct {
+		ResponseId string `json:"responseid"`
+	}
+	if err := json.Unmarshal(data, &response); err == nil && response.ResponseId != "" {
+		if handle, ok := sl.takePending(response.ResponseId); ok {
+			handle(data)
+		}
+	}
+
+	if slices.Contains(sl.Server.ScriptCallbacks, name) {
+		handlers.BroadcastScript(sl.Server.Uuid, name, data)
+	}
+}
+
+// TriggerScriptEvent triggers a mode script event. When a callback is given a unique response id is appended
+// to the params, and it waits for that callback with the same response id and returns its data.
+func TriggerScriptEvent(server *structs.Server, request structs.ScriptTriggerRequest) (structs.ScriptTriggerResponse, error) {
+	timeout := defaultScriptTimeout
+	if request.Timeout > 0 {
+		timeout = time.Duration(request.Timeout) * time.Millisecond
+	}
+
+	responseId, data, err := TriggerAndWait(server, request.Method, request.Params, request.Callback, timeout)
+	return structs.ScriptTriggerResponse{
+		ResponseId: responseId,
+		Callback:   request.Callback,
+		Data:       data,
+	}, err
+}
+
+// TriggerAndWait triggers a mode script event with a unique response id and waits for the callback
+// with the same response id. Without a callback the params are sent as given, no response id is added
+// and it returns right after triggering the event.
+func TriggerAndWait(server *structs.Server, method string, params []string, callback string, timeout time.Duration) (string, json.RawMessage, error) {
+	if server.Client == nil || !server.Client.IsConnected {
+		return "", nil, errors.New("server not connected")
+	}
+
+	if callback == "" {
+		return "", nil, server.Client.TriggerModeScriptEventArray(method, params)
+	}
+
+	responseId := uuid.NewString()
+	params = append(slices.Clone(params), responseId)
+
+	// Registered handlers are never removed, so only known callbacks may be waited for
+	if !isWaitableCallback(server, callback) {
+		return responseId, nil, handlers.ErrScriptCallbackNotAllowed
+	}
+
+	sl := getScriptListener(server)
+	if sl == nil {
+		return responseId, nil, errors.New("script listener not found")
+	}
+
+	sl.register(callback)
+
+	ch := make(chan json.RawMessage, 1)
+	sl.addPending(responseId, func(data json.RawMessage) {
+		ch <- data
+	})
+	defer sl.takePending(responseId)
+
+	if err := server.Client.TriggerModeScriptEventArray(method, params); err != nil {
+		return responseId, nil, err
+	}
+
+	select {
+	case data := <-ch:
+		return responseId, data, nil
+	case <-time.After(timeout):
+		zap.L().Debug("Script callback not received", zap.String("server_uuid", server.Uuid), zap.String("method", method), zap.String("callback", callback))
+		return responseId, nil, handlers.ErrScriptTimeout
+	}
+}
+
+// Reports whether a trigger may wait for the callback, it must be allowlisted for the server
+// or be the response of a "Get" method
+func isWaitableCallback(server *structs.Server, callback string) bool {
+	if slices.Contains(server.ScriptCallbacks, callback) {
+		return true
+	}
+	return strings.HasSuffix(callback, ".Status") || callback == "Trackmania.Scores" || callback == "Maniaplanet.Mode.UseTeams"
+}
+
+// Triggers a "Get" script event and applies the decoded response when its callback arrives.
+// It doesn't wait for the callback, so it can be used from the callback handlers, which would
+// otherwise block the callback it is waiting for. Apply is called once, with an error when the
+// event could not be triggered or the callback timed out.
+func triggerAndApply[T any](server *structs.Server, method string, callback string, apply func(T, error)) {
+	var zero T
+
+	if server.Client == nil || !server.Client.IsConnected {
+		apply(zero, errors.New("server not connected"))
+		return
+	}
+
+	sl := getScriptListener(server)
+	if sl == nil {
+		apply(zero, errors.New("script listener not found"))
+		return
+	}
+
+	sl.register(callback)
+
+	responseId := uuid.NewString()
+	sl.addPending(responseId, func(data json.RawMessage) {
+		var target T
+		if err := json.Unmarshal(data, &target); err != nil {
+			apply(zero, err)
+			return
+		}
+		apply(target, nil)
+	})
+
+	time.AfterFunc(syncScriptTimeout, func() {
+		if _, ok := sl.takePending(responseId); ok {
+			zap.L().Debug("Script callback not received", zap.String("server_uuid", server.Uuid), zap.String("method", method), zap.String("callback", callback))
+			apply(zero, handlers.ErrScriptTimeout)
+		}
+	})
+
+	if err := server.Client.TriggerModeScriptEventArray(method, []string{responseId}); err != nil {
+		if _, ok := sl.takePending(responseId); ok {
+			apply(zero, err)
+		}
+	}
+}
+
+func (sl *ScriptListener) addPending(responseId string, handle func(json.RawMessage)) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	sl.pending[responseId] = handle
+}
+
+// Removes the response handler of a trigger, only the first caller gets it
+func (sl *ScriptListener) takePending(responseId string) (func(json.RawMessage), bool) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	handle, ok := sl.pending[responseId]
+	delete(sl.pending, responseId)
+	return handle, ok
+}
+
+// Returns the JSON data of a script callback
+func rawCallbackData(event any) json.RawMessage {
+	if args, ok := event.([]any); ok && len(args) > 0 {
+		if data, ok := args[0].(string); ok && json.Valid([]byte(data)) {
+			return json.RawMessage(data)
+		}
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		zap.L().Error("Failed to encode script callback", zap.Error(err))
+		return json.RawMessage("null")
+	}
+	return data
+}
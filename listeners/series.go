@@ -0,0 +1,88 @@
+package listeners
+
+import (
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+// Team matches (TMWT/TMWC) are played over a series of maps
+func isTeamMatch(liveInfo *structs.LiveInfo) bool {
+	return liveInfo.Type == "tmwc" || liveInfo.Type == "tmwt"
+}
+
+func newSeries() *structs.SeriesInfo {
+	return &structs.SeriesInfo{
+		Teams:   make(map[int]structs.SeriesTeam),
+		History: []structs.SeriesMapResult{},
+	}
+}
+
+func setSeriesTeam(series *structs.SeriesInfo, id int, name string, mapPoints int, mapsWon int) {
+	t := series.Teams[id]
+	t.Id = id
+	t.Name = name
+	t.MapPoints = mapPoints
+	t.MapsWon = mapsWon
+	series.Teams[id] = t
+}
+
+// Sets the map point and match point flags, the map points limit decides a map and the match points limit the series
+func updateSeriesFlags(liveInfo *structs.LiveInfo) {
+	for id, t := range liveInfo.Series.Teams {
+		t.MapPoint = liveInfo.PointsLimit != nil && t.MapPoints >= *liveInfo.PointsLimit-1
+		t.MatchPoint = t.MapPoint && liveInfo.MapLimit != nil && t.MapsWon >= *liveInfo.MapLimit-1
+		liveInfo.Series.Teams[id] = t
+	}
+}
+
+// Starts the next map of the series. The map number follows the last played map, before the first
+// played map it keeps the number set by onScores, which accounts for the maps won before joining.
+func startSeriesMap(liveInfo *structs.LiveInfo) {
+	series := liveInfo.Series
+	if n := len(series.History); n > 0 {
+		series.MapNumber = series.History[n-1].MapNumber + 1
+	} else if series.MapNumber == 0 {
+		series.MapNumber = 1
+	}
+
+	for id, t := range series.Teams {
+		t.MapPoints = 0
+		series.Teams[id] = t
+	}
+	updateSeriesFlags(liveInfo)
+}
+
+// Adds the result of the map to the history, the winner is the team that reached the map points limit
+func endSeriesMap(liveInfo *structs.LiveInfo, mapUid string) {
+	result := structs.SeriesMapResult{
+		MapNumber:  liveInfo.Series.MapNumber,
+		MapUid:     mapUid,
+		WinnerTeam: -1,
+		MapPoints:  make(map[int]int),
+	}
+
+	best := -1
+	for id, t := range liveInfo.Series.Teams {
+		result.MapPoints[id] = t.MapPoints
+
+		switch {
+		case t.MapPoints > best:
+			best = t.MapPoints
+			result.WinnerTeam = id
+		case t.MapPoints == best:
+			result.WinnerTeam = -1
+		}
+	}
+
+	if liveInfo.PointsLimit != nil && best < *liveInfo.PointsLimit {
+		result.WinnerTeam = -1
+	}
+
+	liveInfo.Series.History = append(liveInfo.Series.History, result)
+}
+
+func (ll *LiveListener) broadcastSeries() {
+	handlers.BroadcastLive(ll.Server.Uuid, map[string]*structs.SeriesInfo{
+		"seriesUpdate": ll.Server.Info.LiveInfo.Series,
+	})
+}
@@ -0,0 +1,28 @@
+package listeners
+
+import (
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"go.uber.org/zap"
+)
+
+// EndSessions ends the sessions of all players on the server, used when the server disconnects or is removed
+func EndSessions(server *structs.Server) {
+	if config.AppEnv.Sessions.EndServer(server.Uuid) {
+		saveSessions()
+	}
+}
+
+func endSession(server *structs.Server, login string) {
+	if config.AppEnv.Sessions.End(server.Uuid, login) {
+		saveSessions()
+	}
+}
+
+func saveSessions() {
+	sessions := config.AppEnv.Sessions.Sessions()
+	if err := lib.WriteFile("./sessions.json", &sessions); err != nil {
+		zap.L().Error("Failed to write sessions.json", zap.Error(err))
+	}
+}
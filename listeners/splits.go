@@ -0,0 +1,85 @@
+package listeners
+
+import (
+	"slices"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/structs"
+)
+
+// Compares the checkpoint of a player against their personal best and the server record
+func (ll *LiveListener) broadcastSplit(pw structs.PlayerWaypoint) {
+	cp := len(pw.CheckpointTimes)
+	if cp == 0 {
+		return
+	}
+
+	time := pw.CheckpointTimes[cp-1]
+	split := structs.Split{
+		Login:      pw.Login,
+		Checkpoint: cp,
+		Time:       time,
+	}
+
+	if p, ok := ll.Server.Info.LiveInfo.Players[pw.Login]; ok && p.BestTime > 0 {
+		split.PersonalBest = splitDelta(time, p.BestCheckpoints, cp)
+	}
+
+	if record := ll.Server.Info.LiveInfo.ServerRecord; record != nil {
+		split.ServerRecord = splitDelta(time, record.Checkpoints, cp)
+	}
+
+	handlers.BroadcastLive(ll.Server.Uuid, map[string]structs.Split{
+		"split": split,
+	})
+}
+
+func splitDelta(time int, checkpoints []int, cp int) *structs.SplitDelta {
+	if len(checkpoints) < cp {
+		return nil
+	}
+
+	delta := time - checkpoints[cp-1]
+	color := structs.SplitColorGreen
+	if delta > 0 {
+		color = structs.SplitColorRed
+	}
+
+	return &structs.SplitDelta{
+		Delta: delta,
+		Color: color,
+	}
+}
+
+// Updates the server record and sends the record webhook when it was beaten
+func (ll *LiveListener) refreshServerRecord() {
+	liveInfo := ll.Server.Info.LiveInfo
+	previous := liveInfo.ServerRecord
+
+	updateServerRecord(liveInfo)
+
+	if liveInfo.ServerRecord == nil || liveInfo.ServerRecord == previous {
+		return
+	}
+
+	handlers.DispatchWebhook(ll.Server.Uuid, structs.WebhookEventRecord, liveInfo.ServerRecord)
+}
+
+// Sets the server record to the best time of the players on the current map
+func updateServerRecord(liveInfo *structs.LiveInfo) {
+	for _, p := range liveInfo.Players {
+		if p.BestTime <= 0 || len(p.BestCheckpoints) == 0 {
+			continue
+		}
+
+		if liveInfo.ServerRecord == nil || p.BestTime < liveInfo.ServerRecord.Time {
+			liveInfo.ServerRecord = &structs.Record{
+				Login:       p.Login,
+				AccountId:   p.AccountId,
+				Name:        p.Name,
+				Time:        p.BestTime,
+				Checkpoints: slices.Clone(p.BestCheckpoints),
+			}
+		}
+	}
+}
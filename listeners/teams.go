@@ -0,0 +1,74 @@
+package listeners
+
+import (
+	"slices"
+
+	"github.com/MRegterschot/GbxConnector/handlers"
+	"github.com/MRegterschot/GbxConnector/structs"
+	"go.uber.org/zap"
+)
+
+// Fills the teams with their colour and emblem from the server and the members from the player list
+func syncTeamRoster(server *structs.Server, players []structs.PlayerInfo) {
+	for id, t := range server.Info.LiveInfo.Teams {
+		// The server numbers the teams from 1, the scripts from 0
+		teamInfo, err := server.Client.GetTeamInfo(id + 1)
+		if err != nil {
+			zap.L().Error("Failed to get team info", zap.String("server_uuid", server.Uuid), zap.Int("team", id), zap.Error(err))
+		} else {
+			t.Color = teamInfo.RGB
+			t.Emblem = teamInfo.EmblemUrl
+		}
+
+		// Members that are still in the team keep their place, so the list stays in join order
+		logins := []string{}
+		for _, player := range players {
+			if player.TeamId == id && !player.IsSpectator {
+				logins = append(logins, player.Login)
+			}
+		}
+
+		members := slices.DeleteFunc(slices.Clone(t.Members), func(member string) bool {
+			return !slices.Contains(logins, member)
+		})
+		for _, login := range logins {
+			if !slices.Contains(members, login) {
+				members = append(members, login)
+			}
+		}
+		t.Members = members
+		server.Info.LiveInfo.Teams[id] = t
+	}
+}
+
+// Moves a player to a team, a negative team id removes the player from all teams.
+// New members are added at the end, so the members stay in join order.
+// Returns true when the roster changed.
+func setTeamMember(liveInfo *structs.LiveInfo, login string, teamId int) bool {
+	changed := false
+	for id, t := range liveInfo.Teams {
+		isMember := slices.Contains(t.Members, login)
+
+		switch {
+		case id == teamId && !isMember:
+			t.Members = append(t.Members, login)
+		case id != teamId && isMember:
+			t.Members = slices.DeleteFunc(t.Members, func(member string) bool {
+				return member == login
+			})
+		default:
+			continue
+		}
+
+		liveInfo.Teams[id] = t
+		changed = true
+	}
+
+	return changed
+}
+
+func (ll *LiveListener) broadcastTeams() {
+	handlers.BroadcastLive(ll.Server.Uuid, map[string]map[int]structs.Team{
+		"teamsChanged": ll.Server.Info.LiveInfo.Teams,
+	})
+}
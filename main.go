@@ -9,6 +9,7 @@ import (
 
 	"github.com/MRegterschot/GbxConnector/app"
 	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/handlers"
 	"github.com/MRegterschot/GbxConnector/lib"
 	"go.uber.org/zap"
 )
@@ -29,6 +30,9 @@ func main() {
 	zap.L().Info("Received shutdown signal, shutting down...")
 
 	app.ShutdownServers(config.AppEnv.Servers)
+	app.StopGrpcServer()
+	handlers.CloseEventSinks()
+	handlers.SaveModeration()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
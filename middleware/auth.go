@@ -7,6 +7,7 @@ import (
 
 	"github.com/MRegterschot/GbxConnector/config"
 	"github.com/MRegterschot/GbxConnector/lib"
+	"github.com/MRegterschot/GbxConnector/structs"
 )
 
 // Context key for storing the authenticated user
@@ -46,12 +47,12 @@ func RequireRoles(admin bool) func(http.Handler) http.Handler {
 
 			user, err := lib.ValidateAndGetUser(token)
 			if err != nil {
-				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				WriteError(w, r, http.StatusUnauthorized, structs.ErrorCodeUnauthorized, "Invalid token", nil)
 				return
 			}
 
 			if !user.Admin {
-				http.Error(w, "Forbidden: insufficient permissions", http.StatusForbidden)
+				WriteError(w, r, http.StatusForbidden, structs.ErrorCodeForbidden, "Forbidden: insufficient permissions", nil)
 				return
 			}
 
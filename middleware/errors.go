@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/MRegterschot/GbxConnector/structs"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	RequestIdHeader     = "X-Request-ID"
+	RequestIdContextKey = contextKey("requestId")
+)
+
+// RequestId adds a request ID to the context and response, the ID of the client is kept when it sends one
+func RequestId(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestId := r.Header.Get(RequestIdHeader)
+		if requestId == "" || len(requestId) > 128 {
+			requestId = uuid.NewString()
+		}
+
+		w.Header().Set(RequestIdHeader, requestId)
+		ctx := context.WithValue(r.Context(), RequestIdContextKey, requestId)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func GetRequestId(r *http.Request) string {
+	requestId, _ := r.Context().Value(RequestIdContextKey).(string)
+	return requestId
+}
+
+// WriteError writes a JSON error body with a stable error code, the details can hold the underlying cause
+func WriteError(w http.ResponseWriter, r *http.Request, status int, code structs.ErrorCode, message string, details any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(structs.ErrorResponse{
+		Code:      code,
+		Message:   message,
+		Details:   details,
+		RequestId: GetRequestId(r),
+	}); err != nil {
+		zap.L().Error("Failed to encode error response", zap.Error(err))
+	}
+}
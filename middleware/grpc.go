@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"net"
+
+	"github.com/MRegterschot/GbxConnector/config"
+	"github.com/MRegterschot/GbxConnector/lib"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcUnaryAuth checks for a valid admin JWT in the authorization metadata, like RequireRoles(true)
+func GrpcUnaryAuth(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := authorizeGrpc(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// GrpcStreamAuth checks for a valid admin JWT in the authorization metadata, like RequireRoles(true)
+func GrpcStreamAuth(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := authorizeGrpc(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func authorizeGrpc(ctx context.Context) (context.Context, error) {
+	// Allow requests from localhost or internal Docker network without token
+	if p, ok := peer.FromContext(ctx); ok {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+
+		if host == "127.0.0.1" || host == "::1" || (config.AppEnv.DockerNetworkRange != "" && lib.IsDockerInternalIP(host, config.AppEnv.DockerNetworkRange)) {
+			return ctx, nil
+		}
+	}
+
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = lib.ExtractBearerToken(values[0])
+		}
+	}
+
+	user, err := lib.ValidateAndGetUser(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+
+	if !user.Admin {
+		return nil, status.Error(codes.PermissionDenied, "Forbidden: insufficient permissions")
+	}
+
+	return context.WithValue(ctx, UserContextKey, user), nil
+}
@@ -0,0 +1,3644 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: gbxconnector.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerUuid    string                 `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerRequest) Reset() {
+	*x = ServerRequest{}
+	mi := &file_gbxconnector_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerRequest) ProtoMessage() {}
+
+func (x *ServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerRequest.ProtoReflect.Descriptor instead.
+func (*ServerRequest) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServerRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+type UpdateServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerUuid    string                 `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	Server        *Server                `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServerRequest) Reset() {
+	*x = UpdateServerRequest{}
+	mi := &file_gbxconnector_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerRequest) ProtoMessage() {}
+
+func (x *UpdateServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServerRequest) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateServerRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type UpdateChatConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerUuid    string                 `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	Config        *ChatConfig            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChatConfigRequest) Reset() {
+	*x = UpdateChatConfigRequest{}
+	mi := &file_gbxconnector_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChatConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChatConfigRequest) ProtoMessage() {}
+
+func (x *UpdateChatConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChatConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChatConfigRequest) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateChatConfigRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *UpdateChatConfigRequest) GetConfig() *ChatConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type StreamServersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only stream these servers, all servers when empty
+	ServerUuids   []string `protobuf:"bytes,1,rep,name=server_uuids,json=serverUuids,proto3" json:"server_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamServersRequest) Reset() {
+	*x = StreamServersRequest{}
+	mi := &file_gbxconnector_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamServersRequest) ProtoMessage() {}
+
+func (x *StreamServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamServersRequest.ProtoReflect.Descriptor instead.
+func (*StreamServersRequest) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamServersRequest) GetServerUuids() []string {
+	if x != nil {
+		return x.ServerUuids
+	}
+	return nil
+}
+
+type DiscordConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookUrl    string                 `protobuf:"bytes,1,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscordConfig) Reset() {
+	*x = DiscordConfig{}
+	mi := &file_gbxconnector_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscordConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscordConfig) ProtoMessage() {}
+
+func (x *DiscordConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscordConfig.ProtoReflect.Descriptor instead.
+func (*DiscordConfig) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DiscordConfig) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *DiscordConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DiscordConfig) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+type Server struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Host            string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	XmlrpcPort      int32                  `protobuf:"varint,5,opt,name=xmlrpc_port,json=xmlrpcPort,proto3" json:"xmlrpc_port,omitempty"`
+	User            string                 `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Pass            string                 `protobuf:"bytes,7,opt,name=pass,proto3" json:"pass,omitempty"`
+	FmUrl           *string                `protobuf:"bytes,8,opt,name=fm_url,json=fmUrl,proto3,oneof" json:"fm_url,omitempty"`
+	Admins          []string               `protobuf:"bytes,9,rep,name=admins,proto3" json:"admins,omitempty"`
+	ScriptCallbacks []string               `protobuf:"bytes,10,rep,name=script_callbacks,json=scriptCallbacks,proto3" json:"script_callbacks,omitempty"`
+	Discord         *DiscordConfig         `protobuf:"bytes,11,opt,name=discord,proto3" json:"discord,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_gbxconnector_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Server) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Server) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Server) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Server) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Server) GetXmlrpcPort() int32 {
+	if x != nil {
+		return x.XmlrpcPort
+	}
+	return 0
+}
+
+func (x *Server) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Server) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+func (x *Server) GetFmUrl() string {
+	if x != nil && x.FmUrl != nil {
+		return *x.FmUrl
+	}
+	return ""
+}
+
+func (x *Server) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *Server) GetScriptCallbacks() []string {
+	if x != nil {
+		return x.ScriptCallbacks
+	}
+	return nil
+}
+
+func (x *Server) GetDiscord() *DiscordConfig {
+	if x != nil {
+		return x.Discord
+	}
+	return nil
+}
+
+type ServerResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Host            string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	XmlrpcPort      int32                  `protobuf:"varint,5,opt,name=xmlrpc_port,json=xmlrpcPort,proto3" json:"xmlrpc_port,omitempty"`
+	User            string                 `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Pass            string                 `protobuf:"bytes,7,opt,name=pass,proto3" json:"pass,omitempty"`
+	FmUrl           *string                `protobuf:"bytes,8,opt,name=fm_url,json=fmUrl,proto3,oneof" json:"fm_url,omitempty"`
+	Admins          []string               `protobuf:"bytes,9,rep,name=admins,proto3" json:"admins,omitempty"`
+	ScriptCallbacks []string               `protobuf:"bytes,10,rep,name=script_callbacks,json=scriptCallbacks,proto3" json:"script_callbacks,omitempty"`
+	Discord         *DiscordConfig         `protobuf:"bytes,11,opt,name=discord,proto3" json:"discord,omitempty"`
+	IsConnected     bool                   `protobuf:"varint,12,opt,name=is_connected,json=isConnected,proto3" json:"is_connected,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ServerResponse) Reset() {
+	*x = ServerResponse{}
+	mi := &file_gbxconnector_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerResponse) ProtoMessage() {}
+
+func (x *ServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerResponse.ProtoReflect.Descriptor instead.
+func (*ServerResponse) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServerResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ServerResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerResponse) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ServerResponse) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ServerResponse) GetXmlrpcPort() int32 {
+	if x != nil {
+		return x.XmlrpcPort
+	}
+	return 0
+}
+
+func (x *ServerResponse) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ServerResponse) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+func (x *ServerResponse) GetFmUrl() string {
+	if x != nil && x.FmUrl != nil {
+		return *x.FmUrl
+	}
+	return ""
+}
+
+func (x *ServerResponse) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetScriptCallbacks() []string {
+	if x != nil {
+		return x.ScriptCallbacks
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetDiscord() *DiscordConfig {
+	if x != nil {
+		return x.Discord
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetIsConnected() bool {
+	if x != nil {
+		return x.IsConnected
+	}
+	return false
+}
+
+type ServerList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []*ServerResponse      `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerList) Reset() {
+	*x = ServerList{}
+	mi := &file_gbxconnector_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerList) ProtoMessage() {}
+
+func (x *ServerList) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerList.ProtoReflect.Descriptor instead.
+func (*ServerList) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ServerList) GetServers() []*ServerResponse {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type CustomCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Response      string                 `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	Admin         bool                   `protobuf:"varint,4,opt,name=admin,proto3" json:"admin,omitempty"`
+	Cooldown      int32                  `protobuf:"varint,5,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomCommand) Reset() {
+	*x = CustomCommand{}
+	mi := &file_gbxconnector_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomCommand) ProtoMessage() {}
+
+func (x *CustomCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomCommand.ProtoReflect.Descriptor instead.
+func (*CustomCommand) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CustomCommand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomCommand) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CustomCommand) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *CustomCommand) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+func (x *CustomCommand) GetCooldown() int32 {
+	if x != nil {
+		return x.Cooldown
+	}
+	return 0
+}
+
+type ModerationConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BannedWords       []string               `protobuf:"bytes,1,rep,name=banned_words,json=bannedWords,proto3" json:"banned_words,omitempty"`
+	MaskCharacter     string                 `protobuf:"bytes,2,opt,name=mask_character,json=maskCharacter,proto3" json:"mask_character,omitempty"`
+	RateLimit         int32                  `protobuf:"varint,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	RateInterval      int32                  `protobuf:"varint,4,opt,name=rate_interval,json=rateInterval,proto3" json:"rate_interval,omitempty"`
+	FloodMuteDuration int32                  `protobuf:"varint,5,opt,name=flood_mute_duration,json=floodMuteDuration,proto3" json:"flood_mute_duration,omitempty"`
+	BlockLinks        bool                   `protobuf:"varint,6,opt,name=block_links,json=blockLinks,proto3" json:"block_links,omitempty"`
+	AllowedDomains    []string               `protobuf:"bytes,7,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ModerationConfig) Reset() {
+	*x = ModerationConfig{}
+	mi := &file_gbxconnector_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationConfig) ProtoMessage() {}
+
+func (x *ModerationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationConfig.ProtoReflect.Descriptor instead.
+func (*ModerationConfig) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ModerationConfig) GetBannedWords() []string {
+	if x != nil {
+		return x.BannedWords
+	}
+	return nil
+}
+
+func (x *ModerationConfig) GetMaskCharacter() string {
+	if x != nil {
+		return x.MaskCharacter
+	}
+	return ""
+}
+
+func (x *ModerationConfig) GetRateLimit() int32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *ModerationConfig) GetRateInterval() int32 {
+	if x != nil {
+		return x.RateInterval
+	}
+	return 0
+}
+
+func (x *ModerationConfig) GetFloodMuteDuration() int32 {
+	if x != nil {
+		return x.FloodMuteDuration
+	}
+	return 0
+}
+
+func (x *ModerationConfig) GetBlockLinks() bool {
+	if x != nil {
+		return x.BlockLinks
+	}
+	return false
+}
+
+func (x *ModerationConfig) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+type ChatConfig struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ManualRouting      bool                   `protobuf:"varint,1,opt,name=manual_routing,json=manualRouting,proto3" json:"manual_routing,omitempty"`
+	MessageFormat      string                 `protobuf:"bytes,2,opt,name=message_format,json=messageFormat,proto3" json:"message_format,omitempty"`
+	ConnectMessage     string                 `protobuf:"bytes,3,opt,name=connect_message,json=connectMessage,proto3" json:"connect_message,omitempty"`
+	DisconnectMessage  string                 `protobuf:"bytes,4,opt,name=disconnect_message,json=disconnectMessage,proto3" json:"disconnect_message,omitempty"`
+	ConnectMessages    map[string]string      `protobuf:"bytes,5,rep,name=connect_messages,json=connectMessages,proto3" json:"connect_messages,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DisconnectMessages map[string]string      `protobuf:"bytes,6,rep,name=disconnect_messages,json=disconnectMessages,proto3" json:"disconnect_messages,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DefaultLanguage    string                 `protobuf:"bytes,7,opt,name=default_language,json=defaultLanguage,proto3" json:"default_language,omitempty"`
+	// Localized messages by key, then by language
+	Messages      *structpb.Struct  `protobuf:"bytes,8,opt,name=messages,proto3" json:"messages,omitempty"`
+	Commands      []*CustomCommand  `protobuf:"bytes,9,rep,name=commands,proto3" json:"commands,omitempty"`
+	Moderation    *ModerationConfig `protobuf:"bytes,10,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatConfig) Reset() {
+	*x = ChatConfig{}
+	mi := &file_gbxconnector_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatConfig) ProtoMessage() {}
+
+func (x *ChatConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatConfig.ProtoReflect.Descriptor instead.
+func (*ChatConfig) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChatConfig) GetManualRouting() bool {
+	if x != nil {
+		return x.ManualRouting
+	}
+	return false
+}
+
+func (x *ChatConfig) GetMessageFormat() string {
+	if x != nil {
+		return x.MessageFormat
+	}
+	return ""
+}
+
+func (x *ChatConfig) GetConnectMessage() string {
+	if x != nil {
+		return x.ConnectMessage
+	}
+	return ""
+}
+
+func (x *ChatConfig) GetDisconnectMessage() string {
+	if x != nil {
+		return x.DisconnectMessage
+	}
+	return ""
+}
+
+func (x *ChatConfig) GetConnectMessages() map[string]string {
+	if x != nil {
+		return x.ConnectMessages
+	}
+	return nil
+}
+
+func (x *ChatConfig) GetDisconnectMessages() map[string]string {
+	if x != nil {
+		return x.DisconnectMessages
+	}
+	return nil
+}
+
+func (x *ChatConfig) GetDefaultLanguage() string {
+	if x != nil {
+		return x.DefaultLanguage
+	}
+	return ""
+}
+
+func (x *ChatConfig) GetMessages() *structpb.Struct {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ChatConfig) GetCommands() []*CustomCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *ChatConfig) GetModeration() *ModerationConfig {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	NickName      string                 `protobuf:"bytes,3,opt,name=nick_name,json=nickName,proto3" json:"nick_name,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	To            []string               `protobuf:"bytes,5,rep,name=to,proto3" json:"to,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_gbxconnector_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChatMessage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type PlayerInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Login                string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	NickName             string                 `protobuf:"bytes,2,opt,name=nick_name,json=nickName,proto3" json:"nick_name,omitempty"`
+	PlayerId             int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TeamId               int32                  `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	SpectatorStatus      int32                  `protobuf:"varint,5,opt,name=spectator_status,json=spectatorStatus,proto3" json:"spectator_status,omitempty"`
+	IsSpectator          bool                   `protobuf:"varint,6,opt,name=is_spectator,json=isSpectator,proto3" json:"is_spectator,omitempty"`
+	IsTemporarySpectator bool                   `protobuf:"varint,7,opt,name=is_temporary_spectator,json=isTemporarySpectator,proto3" json:"is_temporary_spectator,omitempty"`
+	IsPureSpectator      bool                   `protobuf:"varint,8,opt,name=is_pure_spectator,json=isPureSpectator,proto3" json:"is_pure_spectator,omitempty"`
+	AutoTarget           bool                   `protobuf:"varint,9,opt,name=auto_target,json=autoTarget,proto3" json:"auto_target,omitempty"`
+	TargetId             int32                  `protobuf:"varint,10,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PlayerInfo) Reset() {
+	*x = PlayerInfo{}
+	mi := &file_gbxconnector_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerInfo) ProtoMessage() {}
+
+func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
+func (*PlayerInfo) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlayerInfo) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetSpectatorStatus() int32 {
+	if x != nil {
+		return x.SpectatorStatus
+	}
+	return 0
+}
+
+func (x *PlayerInfo) GetIsSpectator() bool {
+	if x != nil {
+		return x.IsSpectator
+	}
+	return false
+}
+
+func (x *PlayerInfo) GetIsTemporarySpectator() bool {
+	if x != nil {
+		return x.IsTemporarySpectator
+	}
+	return false
+}
+
+func (x *PlayerInfo) GetIsPureSpectator() bool {
+	if x != nil {
+		return x.IsPureSpectator
+	}
+	return false
+}
+
+func (x *PlayerInfo) GetAutoTarget() bool {
+	if x != nil {
+		return x.AutoTarget
+	}
+	return false
+}
+
+func (x *PlayerInfo) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type PlayerList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*PlayerInfo          `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerList) Reset() {
+	*x = PlayerList{}
+	mi := &file_gbxconnector_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerList) ProtoMessage() {}
+
+func (x *PlayerList) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerList.ProtoReflect.Descriptor instead.
+func (*PlayerList) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayerList) GetPlayers() []*PlayerInfo {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type ActiveMap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActiveMap) Reset() {
+	*x = ActiveMap{}
+	mi := &file_gbxconnector_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActiveMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveMap) ProtoMessage() {}
+
+func (x *ActiveMap) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveMap.ProtoReflect.Descriptor instead.
+func (*ActiveMap) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ActiveMap) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RoundPoints   int32                  `protobuf:"varint,3,opt,name=round_points,json=roundPoints,proto3" json:"round_points,omitempty"`
+	MatchPoints   int32                  `protobuf:"varint,4,opt,name=match_points,json=matchPoints,proto3" json:"match_points,omitempty"`
+	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	Emblem        string                 `protobuf:"bytes,6,opt,name=emblem,proto3" json:"emblem,omitempty"`
+	Members       []string               `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	Captain       string                 `protobuf:"bytes,8,opt,name=captain,proto3" json:"captain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_gbxconnector_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Team) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetRoundPoints() int32 {
+	if x != nil {
+		return x.RoundPoints
+	}
+	return 0
+}
+
+func (x *Team) GetMatchPoints() int32 {
+	if x != nil {
+		return x.MatchPoints
+	}
+	return 0
+}
+
+func (x *Team) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Team) GetEmblem() string {
+	if x != nil {
+		return x.Emblem
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Team) GetCaptain() string {
+	if x != nil {
+		return x.Captain
+	}
+	return ""
+}
+
+type TeamMap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         map[int32]*Team        `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMap) Reset() {
+	*x = TeamMap{}
+	mi := &file_gbxconnector_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMap) ProtoMessage() {}
+
+func (x *TeamMap) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMap.ProtoReflect.Descriptor instead.
+func (*TeamMap) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TeamMap) GetTeams() map[int32]*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type PlayerRound struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Login              string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	AccountId          string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Team               int32                  `protobuf:"varint,4,opt,name=team,proto3" json:"team,omitempty"`
+	Rank               int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	Finalist           bool                   `protobuf:"varint,6,opt,name=finalist,proto3" json:"finalist,omitempty"`
+	Winner             bool                   `protobuf:"varint,7,opt,name=winner,proto3" json:"winner,omitempty"`
+	Eliminated         bool                   `protobuf:"varint,8,opt,name=eliminated,proto3" json:"eliminated,omitempty"`
+	RoundPoints        int32                  `protobuf:"varint,9,opt,name=round_points,json=roundPoints,proto3" json:"round_points,omitempty"`
+	MatchPoints        int32                  `protobuf:"varint,10,opt,name=match_points,json=matchPoints,proto3" json:"match_points,omitempty"`
+	BestTime           int32                  `protobuf:"varint,11,opt,name=best_time,json=bestTime,proto3" json:"best_time,omitempty"`
+	BestCheckpoints    []int32                `protobuf:"varint,12,rep,packed,name=best_checkpoints,json=bestCheckpoints,proto3" json:"best_checkpoints,omitempty"`
+	PrevTime           int32                  `protobuf:"varint,13,opt,name=prev_time,json=prevTime,proto3" json:"prev_time,omitempty"`
+	PrevCheckpoints    []int32                `protobuf:"varint,14,rep,packed,name=prev_checkpoints,json=prevCheckpoints,proto3" json:"prev_checkpoints,omitempty"`
+	BestLapTime        int32                  `protobuf:"varint,15,opt,name=best_lap_time,json=bestLapTime,proto3" json:"best_lap_time,omitempty"`
+	BestLapCheckpoints []int32                `protobuf:"varint,16,rep,packed,name=best_lap_checkpoints,json=bestLapCheckpoints,proto3" json:"best_lap_checkpoints,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PlayerRound) Reset() {
+	*x = PlayerRound{}
+	mi := &file_gbxconnector_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRound) ProtoMessage() {}
+
+func (x *PlayerRound) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRound.ProtoReflect.Descriptor instead.
+func (*PlayerRound) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PlayerRound) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PlayerRound) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PlayerRound) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerRound) GetTeam() int32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *PlayerRound) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PlayerRound) GetFinalist() bool {
+	if x != nil {
+		return x.Finalist
+	}
+	return false
+}
+
+func (x *PlayerRound) GetWinner() bool {
+	if x != nil {
+		return x.Winner
+	}
+	return false
+}
+
+func (x *PlayerRound) GetEliminated() bool {
+	if x != nil {
+		return x.Eliminated
+	}
+	return false
+}
+
+func (x *PlayerRound) GetRoundPoints() int32 {
+	if x != nil {
+		return x.RoundPoints
+	}
+	return 0
+}
+
+func (x *PlayerRound) GetMatchPoints() int32 {
+	if x != nil {
+		return x.MatchPoints
+	}
+	return 0
+}
+
+func (x *PlayerRound) GetBestTime() int32 {
+	if x != nil {
+		return x.BestTime
+	}
+	return 0
+}
+
+func (x *PlayerRound) GetBestCheckpoints() []int32 {
+	if x != nil {
+		return x.BestCheckpoints
+	}
+	return nil
+}
+
+func (x *PlayerRound) GetPrevTime() int32 {
+	if x != nil {
+		return x.PrevTime
+	}
+	return 0
+}
+
+func (x *PlayerRound) GetPrevCheckpoints() []int32 {
+	if x != nil {
+		return x.PrevCheckpoints
+	}
+	return nil
+}
+
+func (x *PlayerRound) GetBestLapTime() int32 {
+	if x != nil {
+		return x.BestLapTime
+	}
+	return 0
+}
+
+func (x *PlayerRound) GetBestLapCheckpoints() []int32 {
+	if x != nil {
+		return x.BestLapCheckpoints
+	}
+	return nil
+}
+
+type PlayerWaypoint struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Login           string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Time            int32                  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	HasFinished     bool                   `protobuf:"varint,4,opt,name=has_finished,json=hasFinished,proto3" json:"has_finished,omitempty"`
+	HasGivenUp      bool                   `protobuf:"varint,5,opt,name=has_given_up,json=hasGivenUp,proto3" json:"has_given_up,omitempty"`
+	IsFinalist      bool                   `protobuf:"varint,6,opt,name=is_finalist,json=isFinalist,proto3" json:"is_finalist,omitempty"`
+	Checkpoint      int32                  `protobuf:"varint,7,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Lap             int32                  `protobuf:"varint,8,opt,name=lap,proto3" json:"lap,omitempty"`
+	LapTimes        []int32                `protobuf:"varint,9,rep,packed,name=lap_times,json=lapTimes,proto3" json:"lap_times,omitempty"`
+	BestLap         int32                  `protobuf:"varint,10,opt,name=best_lap,json=bestLap,proto3" json:"best_lap,omitempty"`
+	CheckpointTimes []int32                `protobuf:"varint,11,rep,packed,name=checkpoint_times,json=checkpointTimes,proto3" json:"checkpoint_times,omitempty"`
+	Position        int32                  `protobuf:"varint,12,opt,name=position,proto3" json:"position,omitempty"`
+	GapToLeader     int32                  `protobuf:"varint,13,opt,name=gap_to_leader,json=gapToLeader,proto3" json:"gap_to_leader,omitempty"`
+	GapToAhead      int32                  `protobuf:"varint,14,opt,name=gap_to_ahead,json=gapToAhead,proto3" json:"gap_to_ahead,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerWaypoint) Reset() {
+	*x = PlayerWaypoint{}
+	mi := &file_gbxconnector_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerWaypoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerWaypoint) ProtoMessage() {}
+
+func (x *PlayerWaypoint) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerWaypoint.ProtoReflect.Descriptor instead.
+func (*PlayerWaypoint) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PlayerWaypoint) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PlayerWaypoint) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PlayerWaypoint) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *PlayerWaypoint) GetHasFinished() bool {
+	if x != nil {
+		return x.HasFinished
+	}
+	return false
+}
+
+func (x *PlayerWaypoint) GetHasGivenUp() bool {
+	if x != nil {
+		return x.HasGivenUp
+	}
+	return false
+}
+
+func (x *PlayerWaypoint) GetIsFinalist() bool {
+	if x != nil {
+		return x.IsFinalist
+	}
+	return false
+}
+
+func (x *PlayerWaypoint) GetCheckpoint() int32 {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return 0
+}
+
+func (x *PlayerWaypoint) GetLap() int32 {
+	if x != nil {
+		return x.Lap
+	}
+	return 0
+}
+
+func (x *PlayerWaypoint) GetLapTimes() []int32 {
+	if x != nil {
+		return x.LapTimes
+	}
+	return nil
+}
+
+func (x *PlayerWaypoint) GetBestLap() int32 {
+	if x != nil {
+		return x.BestLap
+	}
+	return 0
+}
+
+func (x *PlayerWaypoint) GetCheckpointTimes() []int32 {
+	if x != nil {
+		return x.CheckpointTimes
+	}
+	return nil
+}
+
+func (x *PlayerWaypoint) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PlayerWaypoint) GetGapToLeader() int32 {
+	if x != nil {
+		return x.GapToLeader
+	}
+	return 0
+}
+
+func (x *PlayerWaypoint) GetGapToAhead() int32 {
+	if x != nil {
+		return x.GapToAhead
+	}
+	return 0
+}
+
+type ActiveRound struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Players       map[string]*PlayerWaypoint `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActiveRound) Reset() {
+	*x = ActiveRound{}
+	mi := &file_gbxconnector_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActiveRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveRound) ProtoMessage() {}
+
+func (x *ActiveRound) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveRound.ProtoReflect.Descriptor instead.
+func (*ActiveRound) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ActiveRound) GetPlayers() map[string]*PlayerWaypoint {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Time          int32                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	Checkpoints   []int32                `protobuf:"varint,5,rep,packed,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_gbxconnector_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Record) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *Record) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Record) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Record) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Record) GetCheckpoints() []int32 {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+type SplitDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delta         int32                  `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitDelta) Reset() {
+	*x = SplitDelta{}
+	mi := &file_gbxconnector_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitDelta) ProtoMessage() {}
+
+func (x *SplitDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitDelta.ProtoReflect.Descriptor instead.
+func (*SplitDelta) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SplitDelta) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *SplitDelta) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type Split struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Checkpoint    int32                  `protobuf:"varint,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Time          int32                  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	PersonalBest  *SplitDelta            `protobuf:"bytes,4,opt,name=personal_best,json=personalBest,proto3" json:"personal_best,omitempty"`
+	ServerRecord  *SplitDelta            `protobuf:"bytes,5,opt,name=server_record,json=serverRecord,proto3" json:"server_record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Split) Reset() {
+	*x = Split{}
+	mi := &file_gbxconnector_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Split) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Split) ProtoMessage() {}
+
+func (x *Split) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Split.ProtoReflect.Descriptor instead.
+func (*Split) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Split) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *Split) GetCheckpoint() int32 {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return 0
+}
+
+func (x *Split) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Split) GetPersonalBest() *SplitDelta {
+	if x != nil {
+		return x.PersonalBest
+	}
+	return nil
+}
+
+func (x *Split) GetServerRecord() *SplitDelta {
+	if x != nil {
+		return x.ServerRecord
+	}
+	return nil
+}
+
+type PositionChange struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Login            string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Position         int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	PreviousPosition int32                  `protobuf:"varint,3,opt,name=previous_position,json=previousPosition,proto3" json:"previous_position,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PositionChange) Reset() {
+	*x = PositionChange{}
+	mi := &file_gbxconnector_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionChange) ProtoMessage() {}
+
+func (x *PositionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionChange.ProtoReflect.Descriptor instead.
+func (*PositionChange) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PositionChange) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *PositionChange) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PositionChange) GetPreviousPosition() int32 {
+	if x != nil {
+		return x.PreviousPosition
+	}
+	return 0
+}
+
+type PositionChangeList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*PositionChange      `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionChangeList) Reset() {
+	*x = PositionChangeList{}
+	mi := &file_gbxconnector_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionChangeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionChangeList) ProtoMessage() {}
+
+func (x *PositionChangeList) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionChangeList.ProtoReflect.Descriptor instead.
+func (*PositionChangeList) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PositionChangeList) GetChanges() []*PositionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type KnockoutElimination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Round         int32                  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	Placement     int32                  `protobuf:"varint,5,opt,name=placement,proto3" json:"placement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KnockoutElimination) Reset() {
+	*x = KnockoutElimination{}
+	mi := &file_gbxconnector_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KnockoutElimination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnockoutElimination) ProtoMessage() {}
+
+func (x *KnockoutElimination) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnockoutElimination.ProtoReflect.Descriptor instead.
+func (*KnockoutElimination) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KnockoutElimination) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *KnockoutElimination) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *KnockoutElimination) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KnockoutElimination) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *KnockoutElimination) GetPlacement() int32 {
+	if x != nil {
+		return x.Placement
+	}
+	return 0
+}
+
+type KnockoutInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Round                int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	PlayersRemaining     int32                  `protobuf:"varint,2,opt,name=players_remaining,json=playersRemaining,proto3" json:"players_remaining,omitempty"`
+	EliminationsPerRound int32                  `protobuf:"varint,3,opt,name=eliminations_per_round,json=eliminationsPerRound,proto3" json:"eliminations_per_round,omitempty"`
+	Eliminations         []*KnockoutElimination `protobuf:"bytes,4,rep,name=eliminations,proto3" json:"eliminations,omitempty"`
+	DangerZone           []string               `protobuf:"bytes,5,rep,name=danger_zone,json=dangerZone,proto3" json:"danger_zone,omitempty"`
+	Winner               string                 `protobuf:"bytes,6,opt,name=winner,proto3" json:"winner,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *KnockoutInfo) Reset() {
+	*x = KnockoutInfo{}
+	mi := &file_gbxconnector_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KnockoutInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnockoutInfo) ProtoMessage() {}
+
+func (x *KnockoutInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnockoutInfo.ProtoReflect.Descriptor instead.
+func (*KnockoutInfo) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *KnockoutInfo) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *KnockoutInfo) GetPlayersRemaining() int32 {
+	if x != nil {
+		return x.PlayersRemaining
+	}
+	return 0
+}
+
+func (x *KnockoutInfo) GetEliminationsPerRound() int32 {
+	if x != nil {
+		return x.EliminationsPerRound
+	}
+	return 0
+}
+
+func (x *KnockoutInfo) GetEliminations() []*KnockoutElimination {
+	if x != nil {
+		return x.Eliminations
+	}
+	return nil
+}
+
+func (x *KnockoutInfo) GetDangerZone() []string {
+	if x != nil {
+		return x.DangerZone
+	}
+	return nil
+}
+
+func (x *KnockoutInfo) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+type SeriesTeam struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MapsWon       int32                  `protobuf:"varint,3,opt,name=maps_won,json=mapsWon,proto3" json:"maps_won,omitempty"`
+	MapPoints     int32                  `protobuf:"varint,4,opt,name=map_points,json=mapPoints,proto3" json:"map_points,omitempty"`
+	MapPoint      bool                   `protobuf:"varint,5,opt,name=map_point,json=mapPoint,proto3" json:"map_point,omitempty"`
+	MatchPoint    bool                   `protobuf:"varint,6,opt,name=match_point,json=matchPoint,proto3" json:"match_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesTeam) Reset() {
+	*x = SeriesTeam{}
+	mi := &file_gbxconnector_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesTeam) ProtoMessage() {}
+
+func (x *SeriesTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesTeam.ProtoReflect.Descriptor instead.
+func (*SeriesTeam) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SeriesTeam) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SeriesTeam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SeriesTeam) GetMapsWon() int32 {
+	if x != nil {
+		return x.MapsWon
+	}
+	return 0
+}
+
+func (x *SeriesTeam) GetMapPoints() int32 {
+	if x != nil {
+		return x.MapPoints
+	}
+	return 0
+}
+
+func (x *SeriesTeam) GetMapPoint() bool {
+	if x != nil {
+		return x.MapPoint
+	}
+	return false
+}
+
+func (x *SeriesTeam) GetMatchPoint() bool {
+	if x != nil {
+		return x.MatchPoint
+	}
+	return false
+}
+
+type SeriesMapResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MapNumber     int32                  `protobuf:"varint,1,opt,name=map_number,json=mapNumber,proto3" json:"map_number,omitempty"`
+	MapUid        string                 `protobuf:"bytes,2,opt,name=map_uid,json=mapUid,proto3" json:"map_uid,omitempty"`
+	WinnerTeam    int32                  `protobuf:"varint,3,opt,name=winner_team,json=winnerTeam,proto3" json:"winner_team,omitempty"`
+	MapPoints     map[int32]int32        `protobuf:"bytes,4,rep,name=map_points,json=mapPoints,proto3" json:"map_points,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesMapResult) Reset() {
+	*x = SeriesMapResult{}
+	mi := &file_gbxconnector_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesMapResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesMapResult) ProtoMessage() {}
+
+func (x *SeriesMapResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesMapResult.ProtoReflect.Descriptor instead.
+func (*SeriesMapResult) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SeriesMapResult) GetMapNumber() int32 {
+	if x != nil {
+		return x.MapNumber
+	}
+	return 0
+}
+
+func (x *SeriesMapResult) GetMapUid() string {
+	if x != nil {
+		return x.MapUid
+	}
+	return ""
+}
+
+func (x *SeriesMapResult) GetWinnerTeam() int32 {
+	if x != nil {
+		return x.WinnerTeam
+	}
+	return 0
+}
+
+func (x *SeriesMapResult) GetMapPoints() map[int32]int32 {
+	if x != nil {
+		return x.MapPoints
+	}
+	return nil
+}
+
+type SeriesInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MapNumber     int32                  `protobuf:"varint,1,opt,name=map_number,json=mapNumber,proto3" json:"map_number,omitempty"`
+	Teams         map[int32]*SeriesTeam  `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	History       []*SeriesMapResult     `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesInfo) Reset() {
+	*x = SeriesInfo{}
+	mi := &file_gbxconnector_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesInfo) ProtoMessage() {}
+
+func (x *SeriesInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesInfo.ProtoReflect.Descriptor instead.
+func (*SeriesInfo) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SeriesInfo) GetMapNumber() int32 {
+	if x != nil {
+		return x.MapNumber
+	}
+	return 0
+}
+
+func (x *SeriesInfo) GetTeams() map[int32]*SeriesTeam {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *SeriesInfo) GetHistory() []*SeriesMapResult {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type LiveInfo struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	IsWarmUp          bool                    `protobuf:"varint,1,opt,name=is_warm_up,json=isWarmUp,proto3" json:"is_warm_up,omitempty"`
+	WarmUpRound       *int32                  `protobuf:"varint,2,opt,name=warm_up_round,json=warmUpRound,proto3,oneof" json:"warm_up_round,omitempty"`
+	WarmUpTotalRounds *int32                  `protobuf:"varint,3,opt,name=warm_up_total_rounds,json=warmUpTotalRounds,proto3,oneof" json:"warm_up_total_rounds,omitempty"`
+	Mode              string                  `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Type              string                  `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	CurrentMap        string                  `protobuf:"bytes,6,opt,name=current_map,json=currentMap,proto3" json:"current_map,omitempty"`
+	PointsLimit       *int32                  `protobuf:"varint,7,opt,name=points_limit,json=pointsLimit,proto3,oneof" json:"points_limit,omitempty"`
+	RoundsLimit       *int32                  `protobuf:"varint,8,opt,name=rounds_limit,json=roundsLimit,proto3,oneof" json:"rounds_limit,omitempty"`
+	MapLimit          *int32                  `protobuf:"varint,9,opt,name=map_limit,json=mapLimit,proto3,oneof" json:"map_limit,omitempty"`
+	NbWinners         *int32                  `protobuf:"varint,10,opt,name=nb_winners,json=nbWinners,proto3,oneof" json:"nb_winners,omitempty"`
+	LapsLimit         *int32                  `protobuf:"varint,11,opt,name=laps_limit,json=lapsLimit,proto3,oneof" json:"laps_limit,omitempty"`
+	PointsRepartition []int32                 `protobuf:"varint,12,rep,packed,name=points_repartition,json=pointsRepartition,proto3" json:"points_repartition,omitempty"`
+	PauseAvailable    bool                    `protobuf:"varint,13,opt,name=pause_available,json=pauseAvailable,proto3" json:"pause_available,omitempty"`
+	IsPaused          bool                    `protobuf:"varint,14,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	Maps              []string                `protobuf:"bytes,15,rep,name=maps,proto3" json:"maps,omitempty"`
+	Teams             map[int32]*Team         `protobuf:"bytes,16,rep,name=teams,proto3" json:"teams,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Players           map[string]*PlayerRound `protobuf:"bytes,17,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ActiveRound       *ActiveRound            `protobuf:"bytes,18,opt,name=active_round,json=activeRound,proto3" json:"active_round,omitempty"`
+	ServerRecord      *Record                 `protobuf:"bytes,19,opt,name=server_record,json=serverRecord,proto3" json:"server_record,omitempty"`
+	Knockout          *KnockoutInfo           `protobuf:"bytes,20,opt,name=knockout,proto3" json:"knockout,omitempty"`
+	Series            *SeriesInfo             `protobuf:"bytes,21,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LiveInfo) Reset() {
+	*x = LiveInfo{}
+	mi := &file_gbxconnector_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveInfo) ProtoMessage() {}
+
+func (x *LiveInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveInfo.ProtoReflect.Descriptor instead.
+func (*LiveInfo) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LiveInfo) GetIsWarmUp() bool {
+	if x != nil {
+		return x.IsWarmUp
+	}
+	return false
+}
+
+func (x *LiveInfo) GetWarmUpRound() int32 {
+	if x != nil && x.WarmUpRound != nil {
+		return *x.WarmUpRound
+	}
+	return 0
+}
+
+func (x *LiveInfo) GetWarmUpTotalRounds() int32 {
+	if x != nil && x.WarmUpTotalRounds != nil {
+		return *x.WarmUpTotalRounds
+	}
+	return 0
+}
+
+func (x *LiveInfo) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *LiveInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LiveInfo) GetCurrentMap() string {
+	if x != nil {
+		return x.CurrentMap
+	}
+	return ""
+}
+
+func (x *LiveInfo) GetPointsLimit() int32 {
+	if x != nil && x.PointsLimit != nil {
+		return *x.PointsLimit
+	}
+	return 0
+}
+
+func (x *LiveInfo) GetRoundsLimit() int32 {
+	if x != nil && x.RoundsLimit != nil {
+		return *x.RoundsLimit
+	}
+	return 0
+}
+
+func (x *LiveInfo) GetMapLimit() int32 {
+	if x != nil && x.MapLimit != nil {
+		return *x.MapLimit
+	}
+	return 0
+}
+
+func (x *LiveInfo) GetNbWinners() int32 {
+	if x != nil && x.NbWinners != nil {
+		return *x.NbWinners
+	}
+	return 0
+}
+
+func (x *LiveInfo) GetLapsLimit() int32 {
+	if x != nil && x.LapsLimit != nil {
+		return *x.LapsLimit
+	}
+	return 0
+}
+
+func (x *LiveInfo) GetPointsRepartition() []int32 {
+	if x != nil {
+		return x.PointsRepartition
+	}
+	return nil
+}
+
+func (x *LiveInfo) GetPauseAvailable() bool {
+	if x != nil {
+		return x.PauseAvailable
+	}
+	return false
+}
+
+func (x *LiveInfo) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *LiveInfo) GetMaps() []string {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+func (x *LiveInfo) GetTeams() map[int32]*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *LiveInfo) GetPlayers() map[string]*PlayerRound {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *LiveInfo) GetActiveRound() *ActiveRound {
+	if x != nil {
+		return x.ActiveRound
+	}
+	return nil
+}
+
+func (x *LiveInfo) GetServerRecord() *Record {
+	if x != nil {
+		return x.ServerRecord
+	}
+	return nil
+}
+
+func (x *LiveInfo) GetKnockout() *KnockoutInfo {
+	if x != nil {
+		return x.Knockout
+	}
+	return nil
+}
+
+func (x *LiveInfo) GetSeries() *SeriesInfo {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// The snapshot is sent as an "update" event for every server
+type ServerEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Server        *ServerResponse        `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerEvent) Reset() {
+	*x = ServerEvent{}
+	mi := &file_gbxconnector_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerEvent) ProtoMessage() {}
+
+func (x *ServerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerEvent.ProtoReflect.Descriptor instead.
+func (*ServerEvent) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ServerEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ServerEvent) GetServer() *ServerResponse {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+// The snapshot is sent as an "activeMap" event
+type MapEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerUuid    string                 `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	MapUid        string                 `protobuf:"bytes,3,opt,name=map_uid,json=mapUid,proto3" json:"map_uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MapEvent) Reset() {
+	*x = MapEvent{}
+	mi := &file_gbxconnector_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapEvent) ProtoMessage() {}
+
+func (x *MapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapEvent.ProtoReflect.Descriptor instead.
+func (*MapEvent) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MapEvent) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *MapEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *MapEvent) GetMapUid() string {
+	if x != nil {
+		return x.MapUid
+	}
+	return ""
+}
+
+type PlayersEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ServerUuid string                 `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	Event      string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*PlayersEvent_Player
+	//	*PlayersEvent_Players
+	//	*PlayersEvent_Login
+	Payload       isPlayersEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayersEvent) Reset() {
+	*x = PlayersEvent{}
+	mi := &file_gbxconnector_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayersEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayersEvent) ProtoMessage() {}
+
+func (x *PlayersEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayersEvent.ProtoReflect.Descriptor instead.
+func (*PlayersEvent) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PlayersEvent) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *PlayersEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *PlayersEvent) GetPayload() isPlayersEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PlayersEvent) GetPlayer() *PlayerInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*PlayersEvent_Player); ok {
+			return x.Player
+		}
+	}
+	return nil
+}
+
+func (x *PlayersEvent) GetPlayers() *PlayerList {
+	if x != nil {
+		if x, ok := x.Payload.(*PlayersEvent_Players); ok {
+			return x.Players
+		}
+	}
+	return nil
+}
+
+func (x *PlayersEvent) GetLogin() string {
+	if x != nil {
+		if x, ok := x.Payload.(*PlayersEvent_Login); ok {
+			return x.Login
+		}
+	}
+	return ""
+}
+
+type isPlayersEvent_Payload interface {
+	isPlayersEvent_Payload()
+}
+
+type PlayersEvent_Player struct {
+	Player *PlayerInfo `protobuf:"bytes,3,opt,name=player,proto3,oneof"`
+}
+
+type PlayersEvent_Players struct {
+	Players *PlayerList `protobuf:"bytes,4,opt,name=players,proto3,oneof"`
+}
+
+type PlayersEvent_Login struct {
+	Login string `protobuf:"bytes,5,opt,name=login,proto3,oneof"`
+}
+
+func (*PlayersEvent_Player) isPlayersEvent_Payload() {}
+
+func (*PlayersEvent_Players) isPlayersEvent_Payload() {}
+
+func (*PlayersEvent_Login) isPlayersEvent_Payload() {}
+
+type LiveEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ServerUuid string                 `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	Event      string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*LiveEvent_LiveInfo
+	//	*LiveEvent_ActiveRound
+	//	*LiveEvent_MapUid
+	//	*LiveEvent_Split
+	//	*LiveEvent_PositionChanges
+	//	*LiveEvent_Teams
+	//	*LiveEvent_Knockout
+	//	*LiveEvent_Series
+	//	*LiveEvent_Data
+	Payload       isLiveEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveEvent) Reset() {
+	*x = LiveEvent{}
+	mi := &file_gbxconnector_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveEvent) ProtoMessage() {}
+
+func (x *LiveEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveEvent.ProtoReflect.Descriptor instead.
+func (*LiveEvent) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LiveEvent) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *LiveEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *LiveEvent) GetPayload() isLiveEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetLiveInfo() *LiveInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_LiveInfo); ok {
+			return x.LiveInfo
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetActiveRound() *ActiveRound {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_ActiveRound); ok {
+			return x.ActiveRound
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetMapUid() string {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_MapUid); ok {
+			return x.MapUid
+		}
+	}
+	return ""
+}
+
+func (x *LiveEvent) GetSplit() *Split {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_Split); ok {
+			return x.Split
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetPositionChanges() *PositionChangeList {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_PositionChanges); ok {
+			return x.PositionChanges
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetTeams() *TeamMap {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_Teams); ok {
+			return x.Teams
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetKnockout() *KnockoutInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_Knockout); ok {
+			return x.Knockout
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetSeries() *SeriesInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_Series); ok {
+			return x.Series
+		}
+	}
+	return nil
+}
+
+func (x *LiveEvent) GetData() *structpb.Value {
+	if x != nil {
+		if x, ok := x.Payload.(*LiveEvent_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isLiveEvent_Payload interface {
+	isLiveEvent_Payload()
+}
+
+type LiveEvent_LiveInfo struct {
+	LiveInfo *LiveInfo `protobuf:"bytes,3,opt,name=live_info,json=liveInfo,proto3,oneof"`
+}
+
+type LiveEvent_ActiveRound struct {
+	ActiveRound *ActiveRound `protobuf:"bytes,4,opt,name=active_round,json=activeRound,proto3,oneof"`
+}
+
+type LiveEvent_MapUid struct {
+	MapUid string `protobuf:"bytes,5,opt,name=map_uid,json=mapUid,proto3,oneof"`
+}
+
+type LiveEvent_Split struct {
+	Split *Split `protobuf:"bytes,6,opt,name=split,proto3,oneof"`
+}
+
+type LiveEvent_PositionChanges struct {
+	PositionChanges *PositionChangeList `protobuf:"bytes,7,opt,name=position_changes,json=positionChanges,proto3,oneof"`
+}
+
+type LiveEvent_Teams struct {
+	Teams *TeamMap `protobuf:"bytes,8,opt,name=teams,proto3,oneof"`
+}
+
+type LiveEvent_Knockout struct {
+	Knockout *KnockoutInfo `protobuf:"bytes,9,opt,name=knockout,proto3,oneof"`
+}
+
+type LiveEvent_Series struct {
+	Series *SeriesInfo `protobuf:"bytes,10,opt,name=series,proto3,oneof"`
+}
+
+type LiveEvent_Data struct {
+	// Events without a typed payload yet
+	Data *structpb.Value `protobuf:"bytes,11,opt,name=data,proto3,oneof"`
+}
+
+func (*LiveEvent_LiveInfo) isLiveEvent_Payload() {}
+
+func (*LiveEvent_ActiveRound) isLiveEvent_Payload() {}
+
+func (*LiveEvent_MapUid) isLiveEvent_Payload() {}
+
+func (*LiveEvent_Split) isLiveEvent_Payload() {}
+
+func (*LiveEvent_PositionChanges) isLiveEvent_Payload() {}
+
+func (*LiveEvent_Teams) isLiveEvent_Payload() {}
+
+func (*LiveEvent_Knockout) isLiveEvent_Payload() {}
+
+func (*LiveEvent_Series) isLiveEvent_Payload() {}
+
+func (*LiveEvent_Data) isLiveEvent_Payload() {}
+
+// The recent messages are sent first as "backlog" events
+type ChatEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerUuid    string                 `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Message       *ChatMessage           `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatEvent) Reset() {
+	*x = ChatEvent{}
+	mi := &file_gbxconnector_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatEvent) ProtoMessage() {}
+
+func (x *ChatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
+func (*ChatEvent) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ChatEvent) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ChatEvent) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ScriptEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerUuid    string                 `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Data          *structpb.Value        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScriptEvent) Reset() {
+	*x = ScriptEvent{}
+	mi := &file_gbxconnector_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScriptEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptEvent) ProtoMessage() {}
+
+func (x *ScriptEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gbxconnector_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptEvent.ProtoReflect.Descriptor instead.
+func (*ScriptEvent) Descriptor() ([]byte, []int) {
+	return file_gbxconnector_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ScriptEvent) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *ScriptEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ScriptEvent) GetData() *structpb.Value {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_gbxconnector_proto protoreflect.FileDescriptor
+
+const file_gbxconnector_proto_rawDesc = "" +
+	"\n" +
+	"\x12gbxconnector.proto\x12\fgbxconnector\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"0\n" +
+	"\rServerRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\"d\n" +
+	"\x13UpdateServerRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12,\n" +
+	"\x06server\x18\x02 \x01(\v2\x14.gbxconnector.ServerR\x06server\"l\n" +
+	"\x17UpdateChatConfigRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x120\n" +
+	"\x06config\x18\x02 \x01(\v2\x18.gbxconnector.ChatConfigR\x06config\"9\n" +
+	"\x14StreamServersRequest\x12!\n" +
+	"\fserver_uuids\x18\x01 \x03(\tR\vserverUuids\"q\n" +
+	"\rDiscordConfig\x12\x1f\n" +
+	"\vwebhook_url\x18\x01 \x01(\tR\n" +
+	"webhookUrl\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12#\n" +
+	"\rthumbnail_url\x18\x03 \x01(\tR\fthumbnailUrl\"\xe5\x02\n" +
+	"\x06Server\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12\x1f\n" +
+	"\vxmlrpc_port\x18\x05 \x01(\x05R\n" +
+	"xmlrpcPort\x12\x12\n" +
+	"\x04user\x18\x06 \x01(\tR\x04user\x12\x12\n" +
+	"\x04pass\x18\a \x01(\tR\x04pass\x12\x1a\n" +
+	"\x06fm_url\x18\b \x01(\tH\x01R\x05fmUrl\x88\x01\x01\x12\x16\n" +
+	"\x06admins\x18\t \x03(\tR\x06admins\x12)\n" +
+	"\x10script_callbacks\x18\n" +
+	" \x03(\tR\x0fscriptCallbacks\x125\n" +
+	"\adiscord\x18\v \x01(\v2\x1b.gbxconnector.DiscordConfigR\adiscordB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_fm_url\"\x90\x03\n" +
+	"\x0eServerResponse\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12\x1f\n" +
+	"\vxmlrpc_port\x18\x05 \x01(\x05R\n" +
+	"xmlrpcPort\x12\x12\n" +
+	"\x04user\x18\x06 \x01(\tR\x04user\x12\x12\n" +
+	"\x04pass\x18\a \x01(\tR\x04pass\x12\x1a\n" +
+	"\x06fm_url\x18\b \x01(\tH\x01R\x05fmUrl\x88\x01\x01\x12\x16\n" +
+	"\x06admins\x18\t \x03(\tR\x06admins\x12)\n" +
+	"\x10script_callbacks\x18\n" +
+	" \x03(\tR\x0fscriptCallbacks\x125\n" +
+	"\adiscord\x18\v \x01(\v2\x1b.gbxconnector.DiscordConfigR\adiscord\x12!\n" +
+	"\fis_connected\x18\f \x01(\bR\visConnectedB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_fm_url\"D\n" +
+	"\n" +
+	"ServerList\x126\n" +
+	"\aservers\x18\x01 \x03(\v2\x1c.gbxconnector.ServerResponseR\aservers\"\x93\x01\n" +
+	"\rCustomCommand\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bresponse\x18\x03 \x01(\tR\bresponse\x12\x14\n" +
+	"\x05admin\x18\x04 \x01(\bR\x05admin\x12\x1a\n" +
+	"\bcooldown\x18\x05 \x01(\x05R\bcooldown\"\x9a\x02\n" +
+	"\x10ModerationConfig\x12!\n" +
+	"\fbanned_words\x18\x01 \x03(\tR\vbannedWords\x12%\n" +
+	"\x0emask_character\x18\x02 \x01(\tR\rmaskCharacter\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x03 \x01(\x05R\trateLimit\x12#\n" +
+	"\rrate_interval\x18\x04 \x01(\x05R\frateInterval\x12.\n" +
+	"\x13flood_mute_duration\x18\x05 \x01(\x05R\x11floodMuteDuration\x12\x1f\n" +
+	"\vblock_links\x18\x06 \x01(\bR\n" +
+	"blockLinks\x12'\n" +
+	"\x0fallowed_domains\x18\a \x03(\tR\x0eallowedDomains\"\xd3\x05\n" +
+	"\n" +
+	"ChatConfig\x12%\n" +
+	"\x0emanual_routing\x18\x01 \x01(\bR\rmanualRouting\x12%\n" +
+	"\x0emessage_format\x18\x02 \x01(\tR\rmessageFormat\x12'\n" +
+	"\x0fconnect_message\x18\x03 \x01(\tR\x0econnectMessage\x12-\n" +
+	"\x12disconnect_message\x18\x04 \x01(\tR\x11disconnectMessage\x12X\n" +
+	"\x10connect_messages\x18\x05 \x03(\v2-.gbxconnector.ChatConfig.ConnectMessagesEntryR\x0fconnectMessages\x12a\n" +
+	"\x13disconnect_messages\x18\x06 \x03(\v20.gbxconnector.ChatConfig.DisconnectMessagesEntryR\x12disconnectMessages\x12)\n" +
+	"\x10default_language\x18\a \x01(\tR\x0fdefaultLanguage\x123\n" +
+	"\bmessages\x18\b \x01(\v2\x17.google.protobuf.StructR\bmessages\x127\n" +
+	"\bcommands\x18\t \x03(\v2\x1b.gbxconnector.CustomCommandR\bcommands\x12>\n" +
+	"\n" +
+	"moderation\x18\n" +
+	" \x01(\v2\x1e.gbxconnector.ModerationConfigR\n" +
+	"moderation\x1aB\n" +
+	"\x14ConnectMessagesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
+	"\x17DisconnectMessagesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa8\x01\n" +
+	"\vChatMessage\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1b\n" +
+	"\tnick_name\x18\x03 \x01(\tR\bnickName\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x0e\n" +
+	"\x02to\x18\x05 \x03(\tR\x02to\x12.\n" +
+	"\x04time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\xe3\x02\n" +
+	"\n" +
+	"PlayerInfo\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1b\n" +
+	"\tnick_name\x18\x02 \x01(\tR\bnickName\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\x05R\x06teamId\x12)\n" +
+	"\x10spectator_status\x18\x05 \x01(\x05R\x0fspectatorStatus\x12!\n" +
+	"\fis_spectator\x18\x06 \x01(\bR\visSpectator\x124\n" +
+	"\x16is_temporary_spectator\x18\a \x01(\bR\x14isTemporarySpectator\x12*\n" +
+	"\x11is_pure_spectator\x18\b \x01(\bR\x0fisPureSpectator\x12\x1f\n" +
+	"\vauto_target\x18\t \x01(\bR\n" +
+	"autoTarget\x12\x1b\n" +
+	"\ttarget_id\x18\n" +
+	" \x01(\x05R\btargetId\"@\n" +
+	"\n" +
+	"PlayerList\x122\n" +
+	"\aplayers\x18\x01 \x03(\v2\x18.gbxconnector.PlayerInfoR\aplayers\"\x1d\n" +
+	"\tActiveMap\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\"\xd2\x01\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fround_points\x18\x03 \x01(\x05R\vroundPoints\x12!\n" +
+	"\fmatch_points\x18\x04 \x01(\x05R\vmatchPoints\x12\x14\n" +
+	"\x05color\x18\x05 \x01(\tR\x05color\x12\x16\n" +
+	"\x06emblem\x18\x06 \x01(\tR\x06emblem\x12\x18\n" +
+	"\amembers\x18\a \x03(\tR\amembers\x12\x18\n" +
+	"\acaptain\x18\b \x01(\tR\acaptain\"\x8f\x01\n" +
+	"\aTeamMap\x126\n" +
+	"\x05teams\x18\x01 \x03(\v2 .gbxconnector.TeamMap.TeamsEntryR\x05teams\x1aL\n" +
+	"\n" +
+	"TeamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.gbxconnector.TeamR\x05value:\x028\x01\"\xfe\x03\n" +
+	"\vPlayerRound\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04team\x18\x04 \x01(\x05R\x04team\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\x05R\x04rank\x12\x1a\n" +
+	"\bfinalist\x18\x06 \x01(\bR\bfinalist\x12\x16\n" +
+	"\x06winner\x18\a \x01(\bR\x06winner\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\b \x01(\bR\n" +
+	"eliminated\x12!\n" +
+	"\fround_points\x18\t \x01(\x05R\vroundPoints\x12!\n" +
+	"\fmatch_points\x18\n" +
+	" \x01(\x05R\vmatchPoints\x12\x1b\n" +
+	"\tbest_time\x18\v \x01(\x05R\bbestTime\x12)\n" +
+	"\x10best_checkpoints\x18\f \x03(\x05R\x0fbestCheckpoints\x12\x1b\n" +
+	"\tprev_time\x18\r \x01(\x05R\bprevTime\x12)\n" +
+	"\x10prev_checkpoints\x18\x0e \x03(\x05R\x0fprevCheckpoints\x12\"\n" +
+	"\rbest_lap_time\x18\x0f \x01(\x05R\vbestLapTime\x120\n" +
+	"\x14best_lap_checkpoints\x18\x10 \x03(\x05R\x12bestLapCheckpoints\"\xb6\x03\n" +
+	"\x0ePlayerWaypoint\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\x05R\x04time\x12!\n" +
+	"\fhas_finished\x18\x04 \x01(\bR\vhasFinished\x12 \n" +
+	"\fhas_given_up\x18\x05 \x01(\bR\n" +
+	"hasGivenUp\x12\x1f\n" +
+	"\vis_finalist\x18\x06 \x01(\bR\n" +
+	"isFinalist\x12\x1e\n" +
+	"\n" +
+	"checkpoint\x18\a \x01(\x05R\n" +
+	"checkpoint\x12\x10\n" +
+	"\x03lap\x18\b \x01(\x05R\x03lap\x12\x1b\n" +
+	"\tlap_times\x18\t \x03(\x05R\blapTimes\x12\x19\n" +
+	"\bbest_lap\x18\n" +
+	" \x01(\x05R\abestLap\x12)\n" +
+	"\x10checkpoint_times\x18\v \x03(\x05R\x0fcheckpointTimes\x12\x1a\n" +
+	"\bposition\x18\f \x01(\x05R\bposition\x12\"\n" +
+	"\rgap_to_leader\x18\r \x01(\x05R\vgapToLeader\x12 \n" +
+	"\fgap_to_ahead\x18\x0e \x01(\x05R\n" +
+	"gapToAhead\"\xa9\x01\n" +
+	"\vActiveRound\x12@\n" +
+	"\aplayers\x18\x01 \x03(\v2&.gbxconnector.ActiveRound.PlayersEntryR\aplayers\x1aX\n" +
+	"\fPlayersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.gbxconnector.PlayerWaypointR\x05value:\x028\x01\"\x87\x01\n" +
+	"\x06Record\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04time\x18\x04 \x01(\x05R\x04time\x12 \n" +
+	"\vcheckpoints\x18\x05 \x03(\x05R\vcheckpoints\"8\n" +
+	"\n" +
+	"SplitDelta\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\x05R\x05delta\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\"\xcf\x01\n" +
+	"\x05Split\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1e\n" +
+	"\n" +
+	"checkpoint\x18\x02 \x01(\x05R\n" +
+	"checkpoint\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\x05R\x04time\x12=\n" +
+	"\rpersonal_best\x18\x04 \x01(\v2\x18.gbxconnector.SplitDeltaR\fpersonalBest\x12=\n" +
+	"\rserver_record\x18\x05 \x01(\v2\x18.gbxconnector.SplitDeltaR\fserverRecord\"o\n" +
+	"\x0ePositionChange\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12+\n" +
+	"\x11previous_position\x18\x03 \x01(\x05R\x10previousPosition\"L\n" +
+	"\x12PositionChangeList\x126\n" +
+	"\achanges\x18\x01 \x03(\v2\x1c.gbxconnector.PositionChangeR\achanges\"\x92\x01\n" +
+	"\x13KnockoutElimination\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05round\x18\x04 \x01(\x05R\x05round\x12\x1c\n" +
+	"\tplacement\x18\x05 \x01(\x05R\tplacement\"\x87\x02\n" +
+	"\fKnockoutInfo\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12+\n" +
+	"\x11players_remaining\x18\x02 \x01(\x05R\x10playersRemaining\x124\n" +
+	"\x16eliminations_per_round\x18\x03 \x01(\x05R\x14eliminationsPerRound\x12E\n" +
+	"\feliminations\x18\x04 \x03(\v2!.gbxconnector.KnockoutEliminationR\feliminations\x12\x1f\n" +
+	"\vdanger_zone\x18\x05 \x03(\tR\n" +
+	"dangerZone\x12\x16\n" +
+	"\x06winner\x18\x06 \x01(\tR\x06winner\"\xa8\x01\n" +
+	"\n" +
+	"SeriesTeam\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bmaps_won\x18\x03 \x01(\x05R\amapsWon\x12\x1d\n" +
+	"\n" +
+	"map_points\x18\x04 \x01(\x05R\tmapPoints\x12\x1b\n" +
+	"\tmap_point\x18\x05 \x01(\bR\bmapPoint\x12\x1f\n" +
+	"\vmatch_point\x18\x06 \x01(\bR\n" +
+	"matchPoint\"\xf5\x01\n" +
+	"\x0fSeriesMapResult\x12\x1d\n" +
+	"\n" +
+	"map_number\x18\x01 \x01(\x05R\tmapNumber\x12\x17\n" +
+	"\amap_uid\x18\x02 \x01(\tR\x06mapUid\x12\x1f\n" +
+	"\vwinner_team\x18\x03 \x01(\x05R\n" +
+	"winnerTeam\x12K\n" +
+	"\n" +
+	"map_points\x18\x04 \x03(\v2,.gbxconnector.SeriesMapResult.MapPointsEntryR\tmapPoints\x1a<\n" +
+	"\x0eMapPointsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xf3\x01\n" +
+	"\n" +
+	"SeriesInfo\x12\x1d\n" +
+	"\n" +
+	"map_number\x18\x01 \x01(\x05R\tmapNumber\x129\n" +
+	"\x05teams\x18\x02 \x03(\v2#.gbxconnector.SeriesInfo.TeamsEntryR\x05teams\x127\n" +
+	"\ahistory\x18\x03 \x03(\v2\x1d.gbxconnector.SeriesMapResultR\ahistory\x1aR\n" +
+	"\n" +
+	"TeamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.gbxconnector.SeriesTeamR\x05value:\x028\x01\"\x8c\t\n" +
+	"\bLiveInfo\x12\x1c\n" +
+	"\n" +
+	"is_warm_up\x18\x01 \x01(\bR\bisWarmUp\x12'\n" +
+	"\rwarm_up_round\x18\x02 \x01(\x05H\x00R\vwarmUpRound\x88\x01\x01\x124\n" +
+	"\x14warm_up_total_rounds\x18\x03 \x01(\x05H\x01R\x11warmUpTotalRounds\x88\x01\x01\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x1f\n" +
+	"\vcurrent_map\x18\x06 \x01(\tR\n" +
+	"currentMap\x12&\n" +
+	"\fpoints_limit\x18\a \x01(\x05H\x02R\vpointsLimit\x88\x01\x01\x12&\n" +
+	"\frounds_limit\x18\b \x01(\x05H\x03R\vroundsLimit\x88\x01\x01\x12 \n" +
+	"\tmap_limit\x18\t \x01(\x05H\x04R\bmapLimit\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"nb_winners\x18\n" +
+	" \x01(\x05H\x05R\tnbWinners\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"laps_limit\x18\v \x01(\x05H\x06R\tlapsLimit\x88\x01\x01\x12-\n" +
+	"\x12points_repartition\x18\f \x03(\x05R\x11pointsRepartition\x12'\n" +
+	"\x0fpause_available\x18\r \x01(\bR\x0epauseAvailable\x12\x1b\n" +
+	"\tis_paused\x18\x0e \x01(\bR\bisPaused\x12\x12\n" +
+	"\x04maps\x18\x0f \x03(\tR\x04maps\x127\n" +
+	"\x05teams\x18\x10 \x03(\v2!.gbxconnector.LiveInfo.TeamsEntryR\x05teams\x12=\n" +
+	"\aplayers\x18\x11 \x03(\v2#.gbxconnector.LiveInfo.PlayersEntryR\aplayers\x12<\n" +
+	"\factive_round\x18\x12 \x01(\v2\x19.gbxconnector.ActiveRoundR\vactiveRound\x129\n" +
+	"\rserver_record\x18\x13 \x01(\v2\x14.gbxconnector.RecordR\fserverRecord\x126\n" +
+	"\bknockout\x18\x14 \x01(\v2\x1a.gbxconnector.KnockoutInfoR\bknockout\x120\n" +
+	"\x06series\x18\x15 \x01(\v2\x18.gbxconnector.SeriesInfoR\x06series\x1aL\n" +
+	"\n" +
+	"TeamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.gbxconnector.TeamR\x05value:\x028\x01\x1aU\n" +
+	"\fPlayersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gbxconnector.PlayerRoundR\x05value:\x028\x01B\x10\n" +
+	"\x0e_warm_up_roundB\x17\n" +
+	"\x15_warm_up_total_roundsB\x0f\n" +
+	"\r_points_limitB\x0f\n" +
+	"\r_rounds_limitB\f\n" +
+	"\n" +
+	"_map_limitB\r\n" +
+	"\v_nb_winnersB\r\n" +
+	"\v_laps_limit\"Y\n" +
+	"\vServerEvent\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x124\n" +
+	"\x06server\x18\x02 \x01(\v2\x1c.gbxconnector.ServerResponseR\x06server\"Z\n" +
+	"\bMapEvent\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x17\n" +
+	"\amap_uid\x18\x03 \x01(\tR\x06mapUid\"\xd2\x01\n" +
+	"\fPlayersEvent\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x122\n" +
+	"\x06player\x18\x03 \x01(\v2\x18.gbxconnector.PlayerInfoH\x00R\x06player\x124\n" +
+	"\aplayers\x18\x04 \x01(\v2\x18.gbxconnector.PlayerListH\x00R\aplayers\x12\x16\n" +
+	"\x05login\x18\x05 \x01(\tH\x00R\x05loginB\t\n" +
+	"\apayload\"\xa6\x04\n" +
+	"\tLiveEvent\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x125\n" +
+	"\tlive_info\x18\x03 \x01(\v2\x16.gbxconnector.LiveInfoH\x00R\bliveInfo\x12>\n" +
+	"\factive_round\x18\x04 \x01(\v2\x19.gbxconnector.ActiveRoundH\x00R\vactiveRound\x12\x19\n" +
+	"\amap_uid\x18\x05 \x01(\tH\x00R\x06mapUid\x12+\n" +
+	"\x05split\x18\x06 \x01(\v2\x13.gbxconnector.SplitH\x00R\x05split\x12M\n" +
+	"\x10position_changes\x18\a \x01(\v2 .gbxconnector.PositionChangeListH\x00R\x0fpositionChanges\x12-\n" +
+	"\x05teams\x18\b \x01(\v2\x15.gbxconnector.TeamMapH\x00R\x05teams\x128\n" +
+	"\bknockout\x18\t \x01(\v2\x1a.gbxconnector.KnockoutInfoH\x00R\bknockout\x122\n" +
+	"\x06series\x18\n" +
+	" \x01(\v2\x18.gbxconnector.SeriesInfoH\x00R\x06series\x12,\n" +
+	"\x04data\x18\v \x01(\v2\x16.google.protobuf.ValueH\x00R\x04dataB\t\n" +
+	"\apayload\"w\n" +
+	"\tChatEvent\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x123\n" +
+	"\amessage\x18\x03 \x01(\v2\x19.gbxconnector.ChatMessageR\amessage\"p\n" +
+	"\vScriptEvent\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12*\n" +
+	"\x04data\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x04data2\xc4\b\n" +
+	"\fGbxConnector\x12?\n" +
+	"\vListServers\x12\x16.google.protobuf.Empty\x1a\x18.gbxconnector.ServerList\x12?\n" +
+	"\tAddServer\x12\x14.gbxconnector.Server\x1a\x1c.gbxconnector.ServerResponse\x12O\n" +
+	"\fUpdateServer\x12!.gbxconnector.UpdateServerRequest\x1a\x1c.gbxconnector.ServerResponse\x12C\n" +
+	"\fDeleteServer\x12\x1b.gbxconnector.ServerRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\rGetChatConfig\x12\x1b.gbxconnector.ServerRequest\x1a\x18.gbxconnector.ChatConfig\x12S\n" +
+	"\x10UpdateChatConfig\x12%.gbxconnector.UpdateChatConfigRequest\x1a\x18.gbxconnector.ChatConfig\x12C\n" +
+	"\n" +
+	"GetPlayers\x12\x1b.gbxconnector.ServerRequest\x1a\x18.gbxconnector.PlayerList\x12>\n" +
+	"\x06GetMap\x12\x1b.gbxconnector.ServerRequest\x1a\x17.gbxconnector.ActiveMap\x12B\n" +
+	"\vGetLiveInfo\x12\x1b.gbxconnector.ServerRequest\x1a\x16.gbxconnector.LiveInfo\x12P\n" +
+	"\rStreamServers\x12\".gbxconnector.StreamServersRequest\x1a\x19.gbxconnector.ServerEvent0\x01\x12B\n" +
+	"\tStreamMap\x12\x1b.gbxconnector.ServerRequest\x1a\x16.gbxconnector.MapEvent0\x01\x12J\n" +
+	"\rStreamPlayers\x12\x1b.gbxconnector.ServerRequest\x1a\x1a.gbxconnector.PlayersEvent0\x01\x12D\n" +
+	"\n" +
+	"StreamLive\x12\x1b.gbxconnector.ServerRequest\x1a\x17.gbxconnector.LiveEvent0\x01\x12D\n" +
+	"\n" +
+	"StreamChat\x12\x1b.gbxconnector.ServerRequest\x1a\x17.gbxconnector.ChatEvent0\x01\x12H\n" +
+	"\fStreamScript\x12\x1b.gbxconnector.ServerRequest\x1a\x19.gbxconnector.ScriptEvent0\x01B)Z'github.com/MRegterschot/GbxConnector/pbb\x06proto3"
+
+var (
+	file_gbxconnector_proto_rawDescOnce sync.Once
+	file_gbxconnector_proto_rawDescData []byte
+)
+
+func file_gbxconnector_proto_rawDescGZIP() []byte {
+	file_gbxconnector_proto_rawDescOnce.Do(func() {
+		file_gbxconnector_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gbxconnector_proto_rawDesc), len(file_gbxconnector_proto_rawDesc)))
+	})
+	return file_gbxconnector_proto_rawDescData
+}
+
+var file_gbxconnector_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_gbxconnector_proto_goTypes = []any{
+	(*ServerRequest)(nil),           // 0: gbxconnector.ServerRequest
+	(*UpdateServerRequest)(nil),     // 1: gbxconnector.UpdateServerRequest
+	(*UpdateChatConfigRequest)(nil), // 2: gbxconnector.UpdateChatConfigRequest
+	(*StreamServersRequest)(nil),    // 3: gbxconnector.StreamServersRequest
+	(*DiscordConfig)(nil),           // 4: gbxconnector.DiscordConfig
+	(*Server)(nil),                  // 5: gbxconnector.Server
+	(*ServerResponse)(nil),          // 6: gbxconnector.ServerResponse
+	(*ServerList)(nil),              // 7: gbxconnector.ServerList
+	(*CustomCommand)(nil),           // 8: gbxconnector.CustomCommand
+	(*ModerationConfig)(nil),        // 9: gbxconnector.ModerationConfig
+	(*ChatConfig)(nil),              // 10: gbxconnector.ChatConfig
+	(*ChatMessage)(nil),             // 11: gbxconnector.ChatMessage
+	(*PlayerInfo)(nil),              // 12: gbxconnector.PlayerInfo
+	(*PlayerList)(nil),              // 13: gbxconnector.PlayerList
+	(*ActiveMap)(nil),               // 14: gbxconnector.ActiveMap
+	(*Team)(nil),                    // 15: gbxconnector.Team
+	(*TeamMap)(nil),                 // 16: gbxconnector.TeamMap
+	(*PlayerRound)(nil),             // 17: gbxconnector.PlayerRound
+	(*PlayerWaypoint)(nil),          // 18: gbxconnector.PlayerWaypoint
+	(*ActiveRound)(nil),             // 19: gbxconnector.ActiveRound
+	(*Record)(nil),                  // 20: gbxconnector.Record
+	(*SplitDelta)(nil),              // 21: gbxconnector.SplitDelta
+	(*Split)(nil),                   // 22: gbxconnector.Split
+	(*PositionChange)(nil),          // 23: gbxconnector.PositionChange
+	(*PositionChangeList)(nil),      // 24: gbxconnector.PositionChangeList
+	(*KnockoutElimination)(nil),     // 25: gbxconnector.KnockoutElimination
+	(*KnockoutInfo)(nil),            // 26: gbxconnector.KnockoutInfo
+	(*SeriesTeam)(nil),              // 27: gbxconnector.SeriesTeam
+	(*SeriesMapResult)(nil),         // 28: gbxconnector.SeriesMapResult
+	(*SeriesInfo)(nil),              // 29: gbxconnector.SeriesInfo
+	(*LiveInfo)(nil),                // 30: gbxconnector.LiveInfo
+	(*ServerEvent)(nil),             // 31: gbxconnector.ServerEvent
+	(*MapEvent)(nil),                // 32: gbxconnector.MapEvent
+	(*PlayersEvent)(nil),            // 33: gbxconnector.PlayersEvent
+	(*LiveEvent)(nil),               // 34: gbxconnector.LiveEvent
+	(*ChatEvent)(nil),               // 35: gbxconnector.ChatEvent
+	(*ScriptEvent)(nil),             // 36: gbxconnector.ScriptEvent
+	nil,                             // 37: gbxconnector.ChatConfig.ConnectMessagesEntry
+	nil,                             // 38: gbxconnector.ChatConfig.DisconnectMessagesEntry
+	nil,                             // 39: gbxconnector.TeamMap.TeamsEntry
+	nil,                             // 40: gbxconnector.ActiveRound.PlayersEntry
+	nil,                             // 41: gbxconnector.SeriesMapResult.MapPointsEntry
+	nil,                             // 42: gbxconnector.SeriesInfo.TeamsEntry
+	nil,                             // 43: gbxconnector.LiveInfo.TeamsEntry
+	nil,                             // 44: gbxconnector.LiveInfo.PlayersEntry
+	(*structpb.Struct)(nil),         // 45: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),   // 46: google.protobuf.Timestamp
+	(*structpb.Value)(nil),          // 47: google.protobuf.Value
+	(*emptypb.Empty)(nil),           // 48: google.protobuf.Empty
+}
+var file_gbxconnector_proto_depIdxs = []int32{
+	5,  // 0: gbxconnector.UpdateServerRequest.server:type_name -> gbxconnector.Server
+	10, // 1: gbxconnector.UpdateChatConfigRequest.config:type_name -> gbxconnector.ChatConfig
+	4,  // 2: gbxconnector.Server.discord:type_name -> gbxconnector.DiscordConfig
+	4,  // 3: gbxconnector.ServerResponse.discord:type_name -> gbxconnector.DiscordConfig
+	6,  // 4: gbxconnector.ServerList.servers:type_name -> gbxconnector.ServerResponse
+	37, // 5: gbxconnector.ChatConfig.connect_messages:type_name -> gbxconnector.ChatConfig.ConnectMessagesEntry
+	38, // 6: gbxconnector.ChatConfig.disconnect_messages:type_name -> gbxconnector.ChatConfig.DisconnectMessagesEntry
+	45, // 7: gbxconnector.ChatConfig.messages:type_name -> google.protobuf.Struct
+	8,  // 8: gbxconnector.ChatConfig.commands:type_name -> gbxconnector.CustomCommand
+	9,  // 9: gbxconnector.ChatConfig.moderation:type_name -> gbxconnector.ModerationConfig
+	46, // 10: gbxconnector.ChatMessage.time:type_name -> google.protobuf.Timestamp
+	12, // 11: gbxconnector.PlayerList.players:type_name -> gbxconnector.PlayerInfo
+	39, // 12: gbxconnector.TeamMap.teams:type_name -> gbxconnector.TeamMap.TeamsEntry
+	40, // 13: gbxconnector.ActiveRound.players:type_name -> gbxconnector.ActiveRound.PlayersEntry
+	21, // 14: gbxconnector.Split.personal_best:type_name -> gbxconnector.SplitDelta
+	21, // 15: gbxconnector.Split.server_record:type_name -> gbxconnector.SplitDelta
+	23, // 16: gbxconnector.PositionChangeList.changes:type_name -> gbxconnector.PositionChange
+	25, // 17: gbxconnector.KnockoutInfo.eliminations:type_name -> gbxconnector.KnockoutElimination
+	41, // 18: gbxconnector.SeriesMapResult.map_points:type_name -> gbxconnector.SeriesMapResult.MapPointsEntry
+	42, // 19: gbxconnector.SeriesInfo.teams:type_name -> gbxconnector.SeriesInfo.TeamsEntry
+	28, // 20: gbxconnector.SeriesInfo.history:type_name -> gbxconnector.SeriesMapResult
+	43, // 21: gbxconnector.LiveInfo.teams:type_name -> gbxconnector.LiveInfo.TeamsEntry
+	44, // 22: gbxconnector.LiveInfo.players:type_name -> gbxconnector.LiveInfo.PlayersEntry
+	19, // 23: gbxconnector.LiveInfo.active_round:type_name -> gbxconnector.ActiveRound
+	20, // 24: gbxconnector.LiveInfo.server_record:type_name -> gbxconnector.Record
+	26, // 25: gbxconnector.LiveInfo.knockout:type_name -> gbxconnector.KnockoutInfo
+	29, // 26: gbxconnector.LiveInfo.series:type_name -> gbxconnector.SeriesInfo
+	6,  // 27: gbxconnector.ServerEvent.server:type_name -> gbxconnector.ServerResponse
+	12, // 28: gbxconnector.PlayersEvent.player:type_name -> gbxconnector.PlayerInfo
+	13, // 29: gbxconnector.PlayersEvent.players:type_name -> gbxconnector.PlayerList
+	30, // 30: gbxconnector.LiveEvent.live_info:type_name -> gbxconnector.LiveInfo
+	19, // 31: gbxconnector.LiveEvent.active_round:type_name -> gbxconnector.ActiveRound
+	22, // 32: gbxconnector.LiveEvent.split:type_name -> gbxconnector.Split
+	24, // 33: gbxconnector.LiveEvent.position_changes:type_name -> gbxconnector.PositionChangeList
+	16, // 34: gbxconnector.LiveEvent.teams:type_name -> gbxconnector.TeamMap
+	26, // 35: gbxconnector.LiveEvent.knockout:type_name -> gbxconnector.KnockoutInfo
+	29, // 36: gbxconnector.LiveEvent.series:type_name -> gbxconnector.SeriesInfo
+	47, // 37: gbxconnector.LiveEvent.data:type_name -> google.protobuf.Value
+	11, // 38: gbxconnector.ChatEvent.message:type_name -> gbxconnector.ChatMessage
+	47, // 39: gbxconnector.ScriptEvent.data:type_name -> google.protobuf.Value
+	15, // 40: gbxconnector.TeamMap.TeamsEntry.value:type_name -> gbxconnector.Team
+	18, // 41: gbxconnector.ActiveRound.PlayersEntry.value:type_name -> gbxconnector.PlayerWaypoint
+	27, // 42: gbxconnector.SeriesInfo.TeamsEntry.value:type_name -> gbxconnector.SeriesTeam
+	15, // 43: gbxconnector.LiveInfo.TeamsEntry.value:type_name -> gbxconnector.Team
+	17, // 44: gbxconnector.LiveInfo.PlayersEntry.value:type_name -> gbxconnector.PlayerRound
+	48, // 45: gbxconnector.GbxConnector.ListServers:input_type -> google.protobuf.Empty
+	5,  // 46: gbxconnector.GbxConnector.AddServer:input_type -> gbxconnector.Server
+	1,  // 47: gbxconnector.GbxConnector.UpdateServer:input_type -> gbxconnector.UpdateServerRequest
+	0,  // 48: gbxconnector.GbxConnector.DeleteServer:input_type -> gbxconnector.ServerRequest
+	0,  // 49: gbxconnector.GbxConnector.GetChatConfig:input_type -> gbxconnector.ServerRequest
+	2,  // 50: gbxconnector.GbxConnector.UpdateChatConfig:input_type -> gbxconnector.UpdateChatConfigRequest
+	0,  // 51: gbxconnector.GbxConnector.GetPlayers:input_type -> gbxconnector.ServerRequest
+	0,  // 52: gbxconnector.GbxConnector.GetMap:input_type -> gbxconnector.ServerRequest
+	0,  // 53: gbxconnector.GbxConnector.GetLiveInfo:input_type -> gbxconnector.ServerRequest
+	3,  // 54: gbxconnector.GbxConnector.StreamServers:input_type -> gbxconnector.StreamServersRequest
+	0,  // 55: gbxconnector.GbxConnector.StreamMap:input_type -> gbxconnector.ServerRequest
+	0,  // 56: gbxconnector.GbxConnector.StreamPlayers:input_type -> gbxconnector.ServerRequest
+	0,  // 57: gbxconnector.GbxConnector.StreamLive:input_type -> gbxconnector.ServerRequest
+	0,  // 58: gbxconnector.GbxConnector.StreamChat:input_type -> gbxconnector.ServerRequest
+	0,  // 59: gbxconnector.GbxConnector.StreamScript:input_type -> gbxconnector.ServerRequest
+	7,  // 60: gbxconnector.GbxConnector.ListServers:output_type -> gbxconnector.ServerList
+	6,  // 61: gbxconnector.GbxConnector.AddServer:output_type -> gbxconnector.ServerResponse
+	6,  // 62: gbxconnector.GbxConnector.UpdateServer:output_type -> gbxconnector.ServerResponse
+	48, // 63: gbxconnector.GbxConnector.DeleteServer:output_type -> google.protobuf.Empty
+	10, // 64: gbxconnector.GbxConnector.GetChatConfig:output_type -> gbxconnector.ChatConfig
+	10, // 65: gbxconnector.GbxConnector.UpdateChatConfig:output_type -> gbxconnector.ChatConfig
+	13, // 66: gbxconnector.GbxConnector.GetPlayers:output_type -> gbxconnector.PlayerList
+	14, // 67: gbxconnector.GbxConnector.GetMap:output_type -> gbxconnector.ActiveMap
+	30, // 68: gbxconnector.GbxConnector.GetLiveInfo:output_type -> gbxconnector.LiveInfo
+	31, // 69: gbxconnector.GbxConnector.StreamServers:output_type -> gbxconnector.ServerEvent
+	32, // 70: gbxconnector.GbxConnector.StreamMap:output_type -> gbxconnector.MapEvent
+	33, // 71: gbxconnector.GbxConnector.StreamPlayers:output_type -> gbxconnector.PlayersEvent
+	34, // 72: gbxconnector.GbxConnector.StreamLive:output_type -> gbxconnector.LiveEvent
+	35, // 73: gbxconnector.GbxConnector.StreamChat:output_type -> gbxconnector.ChatEvent
+	36, // 74: gbxconnector.GbxConnector.StreamScript:output_type -> gbxconnector.ScriptEvent
+	60, // [60:75] is the sub-list for method output_type
+	45, // [45:60] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_gbxconnector_proto_init() }
+func file_gbxconnector_proto_init() {
+	if File_gbxconnector_proto != nil {
+		return
+	}
+	file_gbxconnector_proto_msgTypes[5].OneofWrappers = []any{}
+	file_gbxconnector_proto_msgTypes[6].OneofWrappers = []any{}
+	file_gbxconnector_proto_msgTypes[30].OneofWrappers = []any{}
+	file_gbxconnector_proto_msgTypes[33].OneofWrappers = []any{
+		(*PlayersEvent_Player)(nil),
+		(*PlayersEvent_Players)(nil),
+		(*PlayersEvent_Login)(nil),
+	}
+	file_gbxconnector_proto_msgTypes[34].OneofWrappers = []any{
+		(*LiveEvent_LiveInfo)(nil),
+		(*LiveEvent_ActiveRound)(nil),
+		(*LiveEvent_MapUid)(nil),
+		(*LiveEvent_Split)(nil),
+		(*LiveEvent_PositionChanges)(nil),
+		(*LiveEvent_Teams)(nil),
+		(*LiveEvent_Knockout)(nil),
+		(*LiveEvent_Series)(nil),
+		(*LiveEvent_Data)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gbxconnector_proto_rawDesc), len(file_gbxconnector_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   45,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gbxconnector_proto_goTypes,
+		DependencyIndexes: file_gbxconnector_proto_depIdxs,
+		MessageInfos:      file_gbxconnector_proto_msgTypes,
+	}.Build()
+	File_gbxconnector_proto = out.File
+	file_gbxconnector_proto_goTypes = nil
+	file_gbxconnector_proto_depIdxs = nil
+}
@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: gbxconnector.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GbxConnector_ListServers_FullMethodName      = "/gbxconnector.GbxConnector/ListServers"
+	GbxConnector_AddServer_FullMethodName        = "/gbxconnector.GbxConnector/AddServer"
+	GbxConnector_UpdateServer_FullMethodName     = "/gbxconnector.GbxConnector/UpdateServer"
+	GbxConnector_DeleteServer_FullMethodName     = "/gbxconnector.GbxConnector/DeleteServer"
+	GbxConnector_GetChatConfig_FullMethodName    = "/gbxconnector.GbxConnector/GetChatConfig"
+	GbxConnector_UpdateChatConfig_FullMethodName = "/gbxconnector.GbxConnector/UpdateChatConfig"
+	GbxConnector_GetPlayers_FullMethodName       = "/gbxconnector.GbxConnector/GetPlayers"
+	GbxConnector_GetMap_FullMethodName           = "/gbxconnector.GbxConnector/GetMap"
+	GbxConnector_GetLiveInfo_FullMethodName      = "/gbxconnector.GbxConnector/GetLiveInfo"
+	GbxConnector_StreamServers_FullMethodName    = "/gbxconnector.GbxConnector/StreamServers"
+	GbxConnector_StreamMap_FullMethodName        = "/gbxconnector.GbxConnector/StreamMap"
+	GbxConnector_StreamPlayers_FullMethodName    = "/gbxconnector.GbxConnector/StreamPlayers"
+	GbxConnector_StreamLive_FullMethodName       = "/gbxconnector.GbxConnector/StreamLive"
+	GbxConnector_StreamChat_FullMethodName       = "/gbxconnector.GbxConnector/StreamChat"
+	GbxConnector_StreamScript_FullMethodName     = "/gbxconnector.GbxConnector/StreamScript"
+)
+
+// GbxConnectorClient is the client API for GbxConnector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The field names follow the JSON of the REST API and websockets, so the
+// messages mirror structs.ServerResponse, structs.PlayerInfo and structs.LiveInfo.
+type GbxConnectorClient interface {
+	// Servers
+	ListServers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerList, error)
+	AddServer(ctx context.Context, in *Server, opts ...grpc.CallOption) (*ServerResponse, error)
+	UpdateServer(ctx context.Context, in *UpdateServerRequest, opts ...grpc.CallOption) (*ServerResponse, error)
+	DeleteServer(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Chat config
+	GetChatConfig(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*ChatConfig, error)
+	UpdateChatConfig(ctx context.Context, in *UpdateChatConfigRequest, opts ...grpc.CallOption) (*ChatConfig, error)
+	// Queries
+	GetPlayers(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*PlayerList, error)
+	GetMap(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*ActiveMap, error)
+	GetLiveInfo(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*LiveInfo, error)
+	// Event streams, every stream starts with the same snapshot as the websocket
+	StreamServers(ctx context.Context, in *StreamServersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServerEvent], error)
+	StreamMap(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MapEvent], error)
+	StreamPlayers(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlayersEvent], error)
+	StreamLive(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LiveEvent], error)
+	StreamChat(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error)
+	StreamScript(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScriptEvent], error)
+}
+
+type gbxConnectorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGbxConnectorClient(cc grpc.ClientConnInterface) GbxConnectorClient {
+	return &gbxConnectorClient{cc}
+}
+
+func (c *gbxConnectorClient) ListServers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerList)
+	err := c.cc.Invoke(ctx, GbxConnector_ListServers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) AddServer(ctx context.Context, in *Server, opts ...grpc.CallOption) (*ServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerResponse)
+	err := c.cc.Invoke(ctx, GbxConnector_AddServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) UpdateServer(ctx context.Context, in *UpdateServerRequest, opts ...grpc.CallOption) (*ServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerResponse)
+	err := c.cc.Invoke(ctx, GbxConnector_UpdateServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) DeleteServer(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GbxConnector_DeleteServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) GetChatConfig(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*ChatConfig, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatConfig)
+	err := c.cc.Invoke(ctx, GbxConnector_GetChatConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) UpdateChatConfig(ctx context.Context, in *UpdateChatConfigRequest, opts ...grpc.CallOption) (*ChatConfig, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatConfig)
+	err := c.cc.Invoke(ctx, GbxConnector_UpdateChatConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) GetPlayers(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*PlayerList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlayerList)
+	err := c.cc.Invoke(ctx, GbxConnector_GetPlayers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) GetMap(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*ActiveMap, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActiveMap)
+	err := c.cc.Invoke(ctx, GbxConnector_GetMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) GetLiveInfo(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (*LiveInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LiveInfo)
+	err := c.cc.Invoke(ctx, GbxConnector_GetLiveInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gbxConnectorClient) StreamServers(ctx context.Context, in *StreamServersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ServerEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GbxConnector_ServiceDesc.Streams[0], GbxConnector_StreamServers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamServersRequest, ServerEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamServersClient = grpc.ServerStreamingClient[ServerEvent]
+
+func (c *gbxConnectorClient) StreamMap(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MapEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GbxConnector_ServiceDesc.Streams[1], GbxConnector_StreamMap_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServerRequest, MapEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamMapClient = grpc.ServerStreamingClient[MapEvent]
+
+func (c *gbxConnectorClient) StreamPlayers(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlayersEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GbxConnector_ServiceDesc.Streams[2], GbxConnector_StreamPlayers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServerRequest, PlayersEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamPlayersClient = grpc.ServerStreamingClient[PlayersEvent]
+
+func (c *gbxConnectorClient) StreamLive(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LiveEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GbxConnector_ServiceDesc.Streams[3], GbxConnector_StreamLive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServerRequest, LiveEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamLiveClient = grpc.ServerStreamingClient[LiveEvent]
+
+func (c *gbxConnectorClient) StreamChat(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GbxConnector_ServiceDesc.Streams[4], GbxConnector_StreamChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServerRequest, ChatEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamChatClient = grpc.ServerStreamingClient[ChatEvent]
+
+func (c *gbxConnectorClient) StreamScript(ctx context.Context, in *ServerRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScriptEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GbxConnector_ServiceDesc.Streams[5], GbxConnector_StreamScript_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ServerRequest, ScriptEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamScriptClient = grpc.ServerStreamingClient[ScriptEvent]
+
+// GbxConnectorServer is the server API for GbxConnector service.
+// All implementations must embed UnimplementedGbxConnectorServer
+// for forward compatibility.
+//
+// The field names follow the JSON of the REST API and websockets, so the
+// messages mirror structs.ServerResponse, structs.PlayerInfo and structs.LiveInfo.
+type GbxConnectorServer interface {
+	// Servers
+	ListServers(context.Context, *emptypb.Empty) (*ServerList, error)
+	AddServer(context.Context, *Server) (*ServerResponse, error)
+	UpdateServer(context.Context, *UpdateServerRequest) (*ServerResponse, error)
+	DeleteServer(context.Context, *ServerRequest) (*emptypb.Empty, error)
+	// Chat config
+	GetChatConfig(context.Context, *ServerRequest) (*ChatConfig, error)
+	UpdateChatConfig(context.Context, *UpdateChatConfigRequest) (*ChatConfig, error)
+	// Queries
+	GetPlayers(context.Context, *ServerRequest) (*PlayerList, error)
+	GetMap(context.Context, *ServerRequest) (*ActiveMap, error)
+	GetLiveInfo(context.Context, *ServerRequest) (*LiveInfo, error)
+	// Event streams, every stream starts with the same snapshot as the websocket
+	StreamServers(*StreamServersRequest, grpc.ServerStreamingServer[ServerEvent]) error
+	StreamMap(*ServerRequest, grpc.ServerStreamingServer[MapEvent]) error
+	StreamPlayers(*ServerRequest, grpc.ServerStreamingServer[PlayersEvent]) error
+	StreamLive(*ServerRequest, grpc.ServerStreamingServer[LiveEvent]) error
+	StreamChat(*ServerRequest, grpc.ServerStreamingServer[ChatEvent]) error
+	StreamScript(*ServerRequest, grpc.ServerStreamingServer[ScriptEvent]) error
+	mustEmbedUnimplementedGbxConnectorServer()
+}
+
+// UnimplementedGbxConnectorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGbxConnectorServer struct{}
+
+func (UnimplementedGbxConnectorServer) ListServers(context.Context, *emptypb.Empty) (*ServerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServers not implemented")
+}
+func (UnimplementedGbxConnectorServer) AddServer(context.Context, *Server) (*ServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddServer not implemented")
+}
+func (UnimplementedGbxConnectorServer) UpdateServer(context.Context, *UpdateServerRequest) (*ServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServer not implemented")
+}
+func (UnimplementedGbxConnectorServer) DeleteServer(context.Context, *ServerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServer not implemented")
+}
+func (UnimplementedGbxConnectorServer) GetChatConfig(context.Context, *ServerRequest) (*ChatConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatConfig not implemented")
+}
+func (UnimplementedGbxConnectorServer) UpdateChatConfig(context.Context, *UpdateChatConfigRequest) (*ChatConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChatConfig not implemented")
+}
+func (UnimplementedGbxConnectorServer) GetPlayers(context.Context, *ServerRequest) (*PlayerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayers not implemented")
+}
+func (UnimplementedGbxConnectorServer) GetMap(context.Context, *ServerRequest) (*ActiveMap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMap not implemented")
+}
+func (UnimplementedGbxConnectorServer) GetLiveInfo(context.Context, *ServerRequest) (*LiveInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiveInfo not implemented")
+}
+func (UnimplementedGbxConnectorServer) StreamServers(*StreamServersRequest, grpc.ServerStreamingServer[ServerEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamServers not implemented")
+}
+func (UnimplementedGbxConnectorServer) StreamMap(*ServerRequest, grpc.ServerStreamingServer[MapEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMap not implemented")
+}
+func (UnimplementedGbxConnectorServer) StreamPlayers(*ServerRequest, grpc.ServerStreamingServer[PlayersEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPlayers not implemented")
+}
+func (UnimplementedGbxConnectorServer) StreamLive(*ServerRequest, grpc.ServerStreamingServer[LiveEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLive not implemented")
+}
+func (UnimplementedGbxConnectorServer) StreamChat(*ServerRequest, grpc.ServerStreamingServer[ChatEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChat not implemented")
+}
+func (UnimplementedGbxConnectorServer) StreamScript(*ServerRequest, grpc.ServerStreamingServer[ScriptEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamScript not implemented")
+}
+func (UnimplementedGbxConnectorServer) mustEmbedUnimplementedGbxConnectorServer() {}
+func (UnimplementedGbxConnectorServer) testEmbeddedByValue()                      {}
+
+// UnsafeGbxConnectorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GbxConnectorServer will
+// result in compilation errors.
+type UnsafeGbxConnectorServer interface {
+	mustEmbedUnimplementedGbxConnectorServer()
+}
+
+func RegisterGbxConnectorServer(s grpc.ServiceRegistrar, srv GbxConnectorServer) {
+	// If the following call pancis, it indicates UnimplementedGbxConnectorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GbxConnector_ServiceDesc, srv)
+}
+
+func _GbxConnector_ListServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).ListServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_ListServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).ListServers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_AddServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Server)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).AddServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_AddServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).AddServer(ctx, req.(*Server))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_UpdateServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).UpdateServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_UpdateServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).UpdateServer(ctx, req.(*UpdateServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_DeleteServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).DeleteServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_DeleteServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).DeleteServer(ctx, req.(*ServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_GetChatConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).GetChatConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_GetChatConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).GetChatConfig(ctx, req.(*ServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_UpdateChatConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChatConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).UpdateChatConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_UpdateChatConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).UpdateChatConfig(ctx, req.(*UpdateChatConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_GetPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).GetPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_GetPlayers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).GetPlayers(ctx, req.(*ServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_GetMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).GetMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_GetMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).GetMap(ctx, req.(*ServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_GetLiveInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GbxConnectorServer).GetLiveInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GbxConnector_GetLiveInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GbxConnectorServer).GetLiveInfo(ctx, req.(*ServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GbxConnector_StreamServers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamServersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GbxConnectorServer).StreamServers(m, &grpc.GenericServerStream[StreamServersRequest, ServerEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamServersServer = grpc.ServerStreamingServer[ServerEvent]
+
+func _GbxConnector_StreamMap_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GbxConnectorServer).StreamMap(m, &grpc.GenericServerStream[ServerRequest, MapEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamMapServer = grpc.ServerStreamingServer[MapEvent]
+
+func _GbxConnector_StreamPlayers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GbxConnectorServer).StreamPlayers(m, &grpc.GenericServerStream[ServerRequest, PlayersEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamPlayersServer = grpc.ServerStreamingServer[PlayersEvent]
+
+func _GbxConnector_StreamLive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GbxConnectorServer).StreamLive(m, &grpc.GenericServerStream[ServerRequest, LiveEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamLiveServer = grpc.ServerStreamingServer[LiveEvent]
+
+func _GbxConnector_StreamChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GbxConnectorServer).StreamChat(m, &grpc.GenericServerStream[ServerRequest, ChatEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamChatServer = grpc.ServerStreamingServer[ChatEvent]
+
+func _GbxConnector_StreamScript_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GbxConnectorServer).StreamScript(m, &grpc.GenericServerStream[ServerRequest, ScriptEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GbxConnector_StreamScriptServer = grpc.ServerStreamingServer[ScriptEvent]
+
+// GbxConnector_ServiceDesc is the grpc.ServiceDesc for GbxConnector service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GbxConnector_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gbxconnector.GbxConnector",
+	HandlerType: (*GbxConnectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListServers",
+			Handler:    _GbxConnector_ListServers_Handler,
+		},
+		{
+			MethodName: "AddServer",
+			Handler:    _GbxConnector_AddServer_Handler,
+		},
+		{
+			MethodName: "UpdateServer",
+			Handler:    _GbxConnector_UpdateServer_Handler,
+		},
+		{
+			MethodName: "DeleteServer",
+			Handler:    _GbxConnector_DeleteServer_Handler,
+		},
+		{
+			MethodName: "GetChatConfig",
+			Handler:    _GbxConnector_GetChatConfig_Handler,
+		},
+		{
+			MethodName: "UpdateChatConfig",
+			Handler:    _GbxConnector_UpdateChatConfig_Handler,
+		},
+		{
+			MethodName: "GetPlayers",
+			Handler:    _GbxConnector_GetPlayers_Handler,
+		},
+		{
+			MethodName: "GetMap",
+			Handler:    _GbxConnector_GetMap_Handler,
+		},
+		{
+			MethodName: "GetLiveInfo",
+			Handler:    _GbxConnector_GetLiveInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamServers",
+			Handler:       _GbxConnector_StreamServers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamMap",
+			Handler:       _GbxConnector_StreamMap_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPlayers",
+			Handler:       _GbxConnector_StreamPlayers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamLive",
+			Handler:       _GbxConnector_StreamLive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamChat",
+			Handler:       _GbxConnector_StreamChat_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamScript",
+			Handler:       _GbxConnector_StreamScript_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "gbxconnector.proto",
+}
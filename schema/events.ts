@@ -0,0 +1,267 @@
+// Code generated by schemagen. DO NOT EDIT.
+
+export interface Event<Topic extends string = string, Type extends string = string, Payload = unknown> {
+  topic: Topic;
+  type: Type;
+  serverUuid: string;
+  timestamp: string;
+  seq: number;
+  payload: Payload;
+}
+
+export type ServersUpdateEvent = Event<"servers", "update", ServerResponse>;
+export type ServersDeleteEvent = Event<"servers", "delete", string>;
+export type MapActiveMapEvent = Event<"map", "activeMap", string>;
+export type MapStartMapEvent = Event<"map", "startMap", string>;
+export type MapEndMapEvent = Event<"map", "endMap", string>;
+export type PlayersPlayerListEvent = Event<"players", "playerList", PlayerInfo[] | null>;
+export type PlayersConnectEvent = Event<"players", "connect", PlayerInfo>;
+export type PlayersInfoChangedEvent = Event<"players", "infoChanged", PlayerInfo>;
+export type PlayersDisconnectEvent = Event<"players", "disconnect", string>;
+export type LiveBeginMatchEvent = Event<"live", "beginMatch", LiveInfo | null>;
+export type LiveEndRoundEvent = Event<"live", "endRound", LiveInfo | null>;
+export type LivePersonalBestEvent = Event<"live", "personalBest", LiveInfo | null>;
+export type LivePlayerConnectEvent = Event<"live", "playerConnect", LiveInfo | null>;
+export type LiveUpdatedSettingsEvent = Event<"live", "updatedSettings", LiveInfo | null>;
+export type LiveWarmUpStartEvent = Event<"live", "warmUpStart", LiveInfo | null>;
+export type LiveWarmUpEndEvent = Event<"live", "warmUpEnd", LiveInfo | null>;
+export type LiveWarmUpStartRoundEvent = Event<"live", "warmUpStartRound", LiveInfo | null>;
+export type LiveEliminationEvent = Event<"live", "elimination", LiveInfo | null>;
+export type LiveBeginRoundEvent = Event<"live", "beginRound", ActiveRound>;
+export type LiveCheckpointEvent = Event<"live", "checkpoint", ActiveRound>;
+export type LiveFinishEvent = Event<"live", "finish", ActiveRound>;
+export type LiveGiveUpEvent = Event<"live", "giveUp", ActiveRound>;
+export type LiveLapFinishEvent = Event<"live", "lapFinish", ActiveRound>;
+export type LivePlayerDisconnectEvent = Event<"live", "playerDisconnect", ActiveRound>;
+export type LivePlayerInfoChangedEvent = Event<"live", "playerInfoChanged", ActiveRound>;
+export type LiveBeginMapEvent = Event<"live", "beginMap", string>;
+export type LiveEndMapEvent = Event<"live", "endMap", string>;
+export type LiveSplitEvent = Event<"live", "split", Split>;
+export type LivePositionChangeEvent = Event<"live", "positionChange", PositionChange[] | null>;
+export type LiveTeamsChangedEvent = Event<"live", "teamsChanged", Record<string, Team> | null>;
+export type LiveKnockoutEvent = Event<"live", "knockout", KnockoutInfo | null>;
+export type LiveSeriesUpdateEvent = Event<"live", "seriesUpdate", SeriesInfo | null>;
+export type ChatBacklogEvent = Event<"chat", "backlog", ChatMessage[] | null>;
+export type ChatMessageEvent = Event<"chat", "message", ChatMessage>;
+export type ScriptEvent = Event<"script", string, unknown>;
+
+export type AnyEvent =
+  | ServersUpdateEvent
+  | ServersDeleteEvent
+  | MapActiveMapEvent
+  | MapStartMapEvent
+  | MapEndMapEvent
+  | PlayersPlayerListEvent
+  | PlayersConnectEvent
+  | PlayersInfoChangedEvent
+  | PlayersDisconnectEvent
+  | LiveBeginMatchEvent
+  | LiveEndRoundEvent
+  | LivePersonalBestEvent
+  | LivePlayerConnectEvent
+  | LiveUpdatedSettingsEvent
+  | LiveWarmUpStartEvent
+  | LiveWarmUpEndEvent
+  | LiveWarmUpStartRoundEvent
+  | LiveEliminationEvent
+  | LiveBeginRoundEvent
+  | LiveCheckpointEvent
+  | LiveFinishEvent
+  | LiveGiveUpEvent
+  | LiveLapFinishEvent
+  | LivePlayerDisconnectEvent
+  | LivePlayerInfoChangedEvent
+  | LiveBeginMapEvent
+  | LiveEndMapEvent
+  | LiveSplitEvent
+  | LivePositionChangeEvent
+  | LiveTeamsChangedEvent
+  | LiveKnockoutEvent
+  | LiveSeriesUpdateEvent
+  | ChatBacklogEvent
+  | ChatMessageEvent
+  | ScriptEvent
+;
+
+export interface ServerResponse {
+  uuid: string;
+  name: string;
+  description?: string;
+  host: string;
+  xmlrpcPort: number;
+  user: string;
+  pass: string;
+  fmUrl?: string;
+  admins?: string[];
+  scriptCallbacks?: string[];
+  discord?: DiscordConfig;
+  isConnected: boolean;
+}
+
+export interface PlayerInfo {
+  login: string;
+  nickName: string;
+  playerId: number;
+  teamId: number;
+  spectatorStatus: number;
+  isSpectator: boolean;
+  isTemporarySpectator: boolean;
+  isPureSpectator: boolean;
+  autoTarget: boolean;
+  targetId: number;
+}
+
+export interface LiveInfo {
+  isWarmUp: boolean;
+  warmUpRound?: number;
+  warmUpTotalRounds?: number;
+  mode: string;
+  type: string;
+  currentMap: string;
+  pointsLimit?: number;
+  roundsLimit?: number;
+  mapLimit?: number;
+  nbWinners?: number;
+  lapsLimit?: number;
+  pointsRepartition: number[] | null;
+  pauseAvailable: boolean;
+  isPaused: boolean;
+  maps: string[] | null;
+  teams?: Record<string, Team>;
+  players?: Record<string, PlayerRound>;
+  activeRound: ActiveRound;
+  serverRecord?: GbxRecord;
+  knockout?: KnockoutInfo;
+  series?: SeriesInfo;
+}
+
+export interface ActiveRound {
+  players?: Record<string, PlayerWaypoint>;
+}
+
+export interface Split {
+  login: string;
+  checkpoint: number;
+  time: number;
+  personalBest?: SplitDelta;
+  serverRecord?: SplitDelta;
+}
+
+export interface PositionChange {
+  login: string;
+  position: number;
+  previousPosition: number;
+}
+
+export interface Team {
+  id: number;
+  name: string;
+  roundPoints: number;
+  matchPoints: number;
+  color?: string;
+  emblem?: string;
+  members: string[] | null;
+}
+
+export interface KnockoutInfo {
+  round: number;
+  playersRemaining: number;
+  eliminationsPerRound: number;
+  eliminations: KnockoutElimination[] | null;
+  dangerZone: string[] | null;
+  winner?: string;
+}
+
+export interface SeriesInfo {
+  mapNumber: number;
+  teams: Record<string, SeriesTeam> | null;
+  history: SeriesMapResult[] | null;
+}
+
+export interface ChatMessage {
+  type: string;
+  login?: string;
+  nickName?: string;
+  text: string;
+  to?: string[];
+  time: string;
+}
+
+export interface DiscordConfig {
+  webhookUrl?: string;
+  username?: string;
+  thumbnailUrl?: string;
+}
+
+export interface PlayerRound {
+  login: string;
+  accountId: string;
+  name: string;
+  team: number;
+  rank: number;
+  finalist: boolean;
+  winner: boolean;
+  eliminated: boolean;
+  roundPoints: number;
+  matchPoints: number;
+  bestTime: number;
+  bestCheckpoints: number[] | null;
+  prevTime: number;
+  prevCheckpoints: number[] | null;
+  bestLapTime?: number;
+  bestLapCheckpoints?: number[];
+}
+
+export interface GbxRecord {
+  login: string;
+  accountId: string;
+  name: string;
+  time: number;
+  checkpoints: number[] | null;
+}
+
+export interface PlayerWaypoint {
+  login: string;
+  accountId: string;
+  time: number;
+  hasFinished: boolean;
+  hasGivenUp: boolean;
+  isFinalist: boolean;
+  checkpoint: number;
+  lap?: number;
+  lapTimes?: number[];
+  bestLap?: number;
+  checkpointTimes?: number[];
+  position: number;
+  gapToLeader: number;
+  gapToAhead: number;
+}
+
+export interface SplitDelta {
+  delta: number;
+  color: string;
+}
+
+export interface KnockoutElimination {
+  login: string;
+  accountId: string;
+  name: string;
+  round: number;
+  placement: number;
+}
+
+export interface SeriesTeam {
+  id: number;
+  name: string;
+  mapsWon: number;
+  mapPoints: number;
+  mapPoint: boolean;
+  matchPoint: boolean;
+}
+
+export interface SeriesMapResult {
+  mapNumber: number;
+  mapUid: string;
+  winnerTeam: number;
+  mapPoints: Record<string, number> | null;
+}
@@ -0,0 +1,27 @@
+package structs
+
+const DefaultBridgeFormat MessageFormat = "$999[{server}$z$999]$z {nickName}$z: {message}"
+
+type BridgeGroup struct {
+	Id           string        `json:"id"`
+	Name         string        `json:"name,omitempty"`
+	Servers      []string      `json:"servers"`                // Server uuids in the group
+	Format       MessageFormat `json:"format,omitempty"`       // Supports {server}, {login}, {nickName} and {message}
+	RateLimit    int           `json:"rateLimit,omitempty"`    // Max forwarded messages per rate interval, 0 disables the limit
+	RateInterval int           `json:"rateInterval,omitempty"` // Seconds
+}
+
+// Format a message forwarded from another server.
+func (g *BridgeGroup) FormatMessage(serverName string, login string, nickName string, message string) string {
+	format := g.Format
+	if format == "" {
+		format = DefaultBridgeFormat
+	}
+
+	return format.Format(map[string]string{
+		"server":   serverName,
+		"login":    login,
+		"nickName": nickName,
+		"message":  message,
+	})
+}
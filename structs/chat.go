@@ -1,24 +1,78 @@
 package structs
 
 import (
+	"maps"
+	"slices"
 	"strings"
+	"time"
 )
 
 type MessageFormat string
 
+// Message variants by language code, for example "en" or "nl".
+type LocalizedMessage map[string]MessageFormat
+
 type ChatConfig struct {
-	ManualRouting     bool          `json:"manualRouting"`
-	MessageFormat     MessageFormat `json:"messageFormat,omitempty"`
-	ConnectMessage    MessageFormat `json:"connectMessage,omitempty"`
-	DisconnectMessage MessageFormat `json:"disconnectMessage,omitempty"`
+	ManualRouting      bool                        `json:"manualRouting"`
+	MessageFormat      MessageFormat               `json:"messageFormat,omitempty"`
+	ConnectMessage     MessageFormat               `json:"connectMessage,omitempty"`
+	DisconnectMessage  MessageFormat               `json:"disconnectMessage,omitempty"`
+	ConnectMessages    LocalizedMessage            `json:"connectMessages,omitempty"`
+	DisconnectMessages LocalizedMessage            `json:"disconnectMessages,omitempty"`
+	DefaultLanguage    string                      `json:"defaultLanguage,omitempty"`
+	Messages           map[string]LocalizedMessage `json:"messages,omitempty"` // Overrides for the system messages by key
+	Commands           []CustomCommand             `json:"commands,omitempty"`
+	Moderation         ModerationConfig            `json:"moderation"`
+}
+
+// A chat command defined through the chat config, answered with a fixed response.
+type CustomCommand struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Response    MessageFormat `json:"response"`
+	Admin       bool          `json:"admin,omitempty"`
+	Cooldown    int           `json:"cooldown,omitempty"` // Seconds
+}
+
+type ChatMessageType string
+
+const (
+	ChatMessageTypePlayer ChatMessageType = "player"
+	ChatMessageTypeServer ChatMessageType = "server"
+)
+
+type ChatMessage struct {
+	Type     ChatMessageType `json:"type"`
+	Login    string          `json:"login,omitempty"`
+	NickName string          `json:"nickName,omitempty"`
+	Text     string          `json:"text"`
+	To       []string        `json:"to,omitempty"` // Recipients of a message to everyone that was split by language
+	Time     time.Time       `json:"time"`
+}
+
+type SendChatMessageRequest struct {
+	Message string   `json:"message"`
+	Logins  []string `json:"logins,omitempty"`
 }
 
 // Format the message according to the MessageFormat.
 func (f *MessageFormat) FormatMessage(login string, nickName string, message string) string {
-	msg := strings.ReplaceAll(f.String(), "{login}", login)
-	msg = strings.ReplaceAll(msg, "{nickName}", nickName)
-	msg = strings.ReplaceAll(msg, "{message}", message)
-	return strings.TrimSpace(msg)
+	return f.Format(map[string]string{
+		"login":    login,
+		"nickName": nickName,
+		"message":  message,
+	})
+}
+
+// Format the message replacing each {key} with its value.
+// All placeholders are replaced in one pass, so values containing a placeholder are left as is.
+func (f *MessageFormat) Format(vars map[string]string) string {
+	keys := slices.Sorted(maps.Keys(vars))
+	pairs := make([]string, 0, len(keys)*2)
+	for _, key := range keys {
+		pairs = append(pairs, "{"+key+"}", vars[key])
+	}
+	return strings.TrimSpace(strings.NewReplacer(pairs...).Replace(f.String()))
 }
 
 func (f *MessageFormat) String() string {
@@ -27,3 +81,25 @@ func (f *MessageFormat) String() string {
 	}
 	return string(*f)
 }
+
+// Returns the variant for the language, falling back to the base language and then the fallback language.
+func (m LocalizedMessage) Resolve(language string, fallback string) (MessageFormat, bool) {
+	for _, lang := range []string{language, BaseLanguage(language), fallback, BaseLanguage(fallback)} {
+		if lang == "" {
+			continue
+		}
+		if format, ok := m[lang]; ok && format != "" {
+			return format, true
+		}
+	}
+	return "", false
+}
+
+// Normalizes a language code such as "en-US" to "en".
+func BaseLanguage(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+	return language
+}
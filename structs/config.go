@@ -10,5 +10,11 @@ type Env struct {
 	ReconnectInterval  time.Duration
 	JwtSecret          string
 	DockerNetworkRange string
-	Servers            ServerList `json:"servers"`
+	NatsUrl            string
+	NatsSubjectPrefix  string
+	GrpcPort           int
+	Servers            ServerList     `json:"servers"`
+	Bridges            []*BridgeGroup `json:"bridges"`
+	Webhooks           []*Webhook     `json:"webhooks"`
+	Sessions           *SessionStore  `json:"-"`
 }
@@ -0,0 +1,36 @@
+package structs
+
+type DiscordConfig struct {
+	WebhookUrl   string `json:"webhookUrl,omitempty"` // Left out of responses
+	Username     string `json:"username,omitempty"`
+	ThumbnailUrl string `json:"thumbnailUrl,omitempty"` // Map thumbnail, supports {uid}
+}
+
+type DiscordWebhookPayload struct {
+	Username string         `json:"username,omitempty"`
+	Embeds   []DiscordEmbed `json:"embeds"`
+}
+
+type DiscordEmbed struct {
+	Title       string              `json:"title,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Color       int                 `json:"color,omitempty"`
+	Fields      []DiscordEmbedField `json:"fields,omitempty"`
+	Thumbnail   *DiscordEmbedImage  `json:"thumbnail,omitempty"`
+	Footer      *DiscordEmbedFooter `json:"footer,omitempty"`
+	Timestamp   string              `json:"timestamp,omitempty"`
+}
+
+type DiscordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+type DiscordEmbedImage struct {
+	Url string `json:"url"`
+}
+
+type DiscordEmbedFooter struct {
+	Text string `json:"text"`
+}
@@ -0,0 +1,39 @@
+package structs
+
+import "errors"
+
+var (
+	ErrServerNotFound = errors.New("server not found")
+	ErrServerExists   = errors.New("server already exists")
+)
+
+// Stable error codes, clients can rely on these instead of the message
+type ErrorCode string
+
+const (
+	ErrorCodeInvalidRequest     ErrorCode = "invalid_request"
+	ErrorCodeValidationFailed   ErrorCode = "validation_failed"
+	ErrorCodeUnauthorized       ErrorCode = "unauthorized"
+	ErrorCodeForbidden          ErrorCode = "forbidden"
+	ErrorCodeNotFound           ErrorCode = "not_found"
+	ErrorCodeMethodNotAllowed   ErrorCode = "method_not_allowed"
+	ErrorCodeServerNotFound     ErrorCode = "server_not_found"
+	ErrorCodeServerExists       ErrorCode = "server_exists"
+	ErrorCodeServerNotConnected ErrorCode = "server_not_connected"
+	ErrorCodeUpstream           ErrorCode = "upstream_error"
+	ErrorCodeTimeout            ErrorCode = "timeout"
+	ErrorCodeInternal           ErrorCode = "internal_error"
+)
+
+type ErrorResponse struct {
+	Code      ErrorCode `json:"code"`
+	Message   string    `json:"message"`
+	Details   any       `json:"details,omitempty"`
+	RequestId string    `json:"requestId"`
+}
+
+// A validation error for a single field of a request body
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,70 @@
+package structs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event is the envelope of every event sent on the events websocket
+type Event struct {
+	Topic      string          `json:"topic"`
+	Type       string          `json:"type"`
+	ServerUuid string          `json:"serverUuid"`
+	Timestamp  time.Time       `json:"timestamp"`
+	Seq        uint64          `json:"seq"` // Increases by one for every event of the server
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// EventDefinition describes the payload of an event type, used to generate the schema and TypeScript definitions
+type EventDefinition struct {
+	Topic   string
+	Type    string // Empty when the event can have any name, like the script callbacks
+	Payload any    // Zero value of the payload type
+}
+
+// Every event that is broadcast, run go generate after a change.
+// The schemagen tests fail when a broadcast event is missing or the schema is out of date.
+//
+//go:generate go run ../cmd/schemagen -out ../schema
+var EventDefinitions = []EventDefinition{
+	{Topic: "servers", Type: "update", Payload: ServerResponse{}},
+	{Topic: "servers", Type: "delete", Payload: ""},
+
+	{Topic: "map", Type: "activeMap", Payload: ""},
+	{Topic: "map", Type: "startMap", Payload: ""},
+	{Topic: "map", Type: "endMap", Payload: ""},
+
+	{Topic: "players", Type: "playerList", Payload: []PlayerInfo{}},
+	{Topic: "players", Type: "connect", Payload: PlayerInfo{}},
+	{Topic: "players", Type: "infoChanged", Payload: PlayerInfo{}},
+	{Topic: "players", Type: "disconnect", Payload: ""},
+
+	{Topic: "live", Type: "beginMatch", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "endRound", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "personalBest", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "playerConnect", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "updatedSettings", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "warmUpStart", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "warmUpEnd", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "warmUpStartRound", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "elimination", Payload: &LiveInfo{}},
+	{Topic: "live", Type: "beginRound", Payload: ActiveRound{}},
+	{Topic: "live", Type: "checkpoint", Payload: ActiveRound{}},
+	{Topic: "live", Type: "finish", Payload: ActiveRound{}},
+	{Topic: "live", Type: "giveUp", Payload: ActiveRound{}},
+	{Topic: "live", Type: "lapFinish", Payload: ActiveRound{}},
+	{Topic: "live", Type: "playerDisconnect", Payload: ActiveRound{}},
+	{Topic: "live", Type: "playerInfoChanged", Payload: ActiveRound{}},
+	{Topic: "live", Type: "beginMap", Payload: ""},
+	{Topic: "live", Type: "endMap", Payload: ""},
+	{Topic: "live", Type: "split", Payload: Split{}},
+	{Topic: "live", Type: "positionChange", Payload: []PositionChange{}},
+	{Topic: "live", Type: "teamsChanged", Payload: map[int]Team{}},
+	{Topic: "live", Type: "knockout", Payload: &KnockoutInfo{}},
+	{Topic: "live", Type: "seriesUpdate", Payload: &SeriesInfo{}},
+
+	{Topic: "chat", Type: "backlog", Payload: []ChatMessage{}},
+	{Topic: "chat", Type: "message", Payload: ChatMessage{}},
+
+	{Topic: "script", Payload: json.RawMessage{}},
+}
@@ -11,6 +11,7 @@ type LiveInfo struct {
 	RoundsLimit       *int     `json:"roundsLimit,omitempty"`
 	MapLimit          *int     `json:"mapLimit,omitempty"`
 	NbWinners         *int     `json:"nbWinners,omitempty"`
+	LapsLimit         *int     `json:"lapsLimit,omitempty"`
 	PointsRepartition []int    `json:"pointsRepartition"`
 	PauseAvailable    bool     `json:"pauseAvailable"`
 	IsPaused          bool     `json:"isPaused"`
@@ -19,31 +20,40 @@ type LiveInfo struct {
 	Teams   map[int]Team           `json:"teams,omitempty"`
 	Players map[string]PlayerRound `json:"players,omitempty"`
 
-	ActiveRound ActiveRound `json:"activeRound"`
+	ActiveRound  ActiveRound `json:"activeRound"`
+	ServerRecord *Record     `json:"serverRecord,omitempty"`
+
+	Knockout *KnockoutInfo `json:"knockout,omitempty"`
+	Series   *SeriesInfo   `json:"series,omitempty"`
 }
 
 type Team struct {
-	Id          int    `json:"id"`
-	Name        string `json:"name"`
-	RoundPoints int    `json:"roundPoints"`
-	MatchPoints int    `json:"matchPoints"`
+	Id          int      `json:"id"`
+	Name        string   `json:"name"`
+	RoundPoints int      `json:"roundPoints"`
+	MatchPoints int      `json:"matchPoints"`
+	Color       string   `json:"color,omitempty"`
+	Emblem      string   `json:"emblem,omitempty"`
+	Members     []string `json:"members"`
 }
 
 type PlayerRound struct {
-	Login           string `json:"login"`
-	AccountId       string `json:"accountId"`
-	Name            string `json:"name"`
-	Team            int    `json:"team"`
-	Rank            int    `json:"rank"`
-	Finalist        bool   `json:"finalist"`
-	Winner          bool   `json:"winner"`
-	Eliminated      bool   `json:"eliminated"`
-	RoundPoints     int    `json:"roundPoints"`
-	MatchPoints     int    `json:"matchPoints"`
-	BestTime        int    `json:"bestTime"`
-	BestCheckpoints []int  `json:"bestCheckpoints"`
-	PrevTime        int    `json:"prevTime"`
-	PrevCheckpoints []int  `json:"prevCheckpoints"`
+	Login              string `json:"login"`
+	AccountId          string `json:"accountId"`
+	Name               string `json:"name"`
+	Team               int    `json:"team"`
+	Rank               int    `json:"rank"`
+	Finalist           bool   `json:"finalist"`
+	Winner             bool   `json:"winner"`
+	Eliminated         bool   `json:"eliminated"`
+	RoundPoints        int    `json:"roundPoints"`
+	MatchPoints        int    `json:"matchPoints"`
+	BestTime           int    `json:"bestTime"`
+	BestCheckpoints    []int  `json:"bestCheckpoints"`
+	PrevTime           int    `json:"prevTime"`
+	PrevCheckpoints    []int  `json:"prevCheckpoints"`
+	BestLapTime        int    `json:"bestLapTime,omitempty"`
+	BestLapCheckpoints []int  `json:"bestLapCheckpoints,omitempty"`
 }
 
 type ActiveRound struct {
@@ -58,6 +68,86 @@ type PlayerWaypoint struct {
 	HasGivenUp  bool   `json:"hasGivenUp"`
 	IsFinalist  bool   `json:"isFinalist"`
 	Checkpoint  int    `json:"checkpoint"`
+	Lap         int    `json:"lap,omitempty"`
+	LapTimes    []int  `json:"lapTimes,omitempty"`
+	BestLap     int    `json:"bestLap,omitempty"`
+
+	CheckpointTimes []int `json:"checkpointTimes,omitempty"`
+	Position        int   `json:"position"`
+	GapToLeader     int   `json:"gapToLeader"`
+	GapToAhead      int   `json:"gapToAhead"`
+}
+
+type Record struct {
+	Login       string `json:"login"`
+	AccountId   string `json:"accountId"`
+	Name        string `json:"name"`
+	Time        int    `json:"time"`
+	Checkpoints []int  `json:"checkpoints"`
+}
+
+const (
+	SplitColorGreen = "green"
+	SplitColorRed   = "red"
+)
+
+type SplitDelta struct {
+	Delta int    `json:"delta"` // Negative when faster than the reference
+	Color string `json:"color"`
+}
+
+type Split struct {
+	Login        string      `json:"login"`
+	Checkpoint   int         `json:"checkpoint"`
+	Time         int         `json:"time"`
+	PersonalBest *SplitDelta `json:"personalBest,omitempty"`
+	ServerRecord *SplitDelta `json:"serverRecord,omitempty"`
+}
+
+type PositionChange struct {
+	Login            string `json:"login"`
+	Position         int    `json:"position"`
+	PreviousPosition int    `json:"previousPosition"`
+}
+
+type KnockoutInfo struct {
+	Round                int                   `json:"round"`
+	PlayersRemaining     int                   `json:"playersRemaining"`
+	EliminationsPerRound int                   `json:"eliminationsPerRound"`
+	Eliminations         []KnockoutElimination `json:"eliminations"`
+	DangerZone           []string              `json:"dangerZone"`
+	Winner               string                `json:"winner,omitempty"`
+}
+
+type KnockoutElimination struct {
+	Login     string `json:"login"`
+	AccountId string `json:"accountId"`
+	Name      string `json:"name"`
+	Round     int    `json:"round"`
+	Placement int    `json:"placement"`
+}
+
+// Team match (TMWT/TMWC) series state
+type SeriesInfo struct {
+	MapNumber int                `json:"mapNumber"`
+	Teams     map[int]SeriesTeam `json:"teams"`
+	History   []SeriesMapResult  `json:"history"`
+}
+
+type SeriesTeam struct {
+	Id         int    `json:"id"`
+	Name       string `json:"name"`
+	MapsWon    int    `json:"mapsWon"`
+	MapPoints  int    `json:"mapPoints"`
+	MapPoint   bool   `json:"mapPoint"`
+	MatchPoint bool   `json:"matchPoint"`
+}
+
+type SeriesMapResult struct {
+	MapNumber  int         `json:"mapNumber"`
+	MapUid     string      `json:"mapUid"`
+	WinnerTeam int         `json:"winnerTeam"` // -1 when there is no winner
+	MapPoints  map[int]int `json:"mapPoints"`
 }
 
 type WarmUpStatus struct {
@@ -71,10 +161,18 @@ type Scores struct {
 	Section    string                `json:"section"`
 	UseTeams   bool                  `json:"useteams"`
 	WinnerTeam int                   `json:"winnerteam"`
-	Teams      []Team                `json:"teams"`
+	Teams      []CallbackTeam        `json:"teams"`
 	Players    []CallbackPlayerRound `json:"players"`
 }
 
+type CallbackTeam struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	RoundPoints int    `json:"roundpoints"`
+	MapPoints   int    `json:"mappoints"`
+	MatchPoints int    `json:"matchpoints"`
+}
+
 type UseTeams struct {
 	ResponseId string `json:"responseid"`
 	Teams      bool   `json:"teams"`
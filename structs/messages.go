@@ -0,0 +1,116 @@
+package structs
+
+const DefaultLanguage = "en"
+
+// Keys of the system messages sent by the connector
+const (
+	MessageCommandNoPermission = "command.noPermission"
+	MessageCommandUsage        = "command.usage"
+	MessageCommandCooldown     = "command.cooldown"
+	MessageCommandHelp         = "command.help"
+	MessageCommandSkipVote     = "command.skipVote"
+	MessageCommandSkipPassed   = "command.skipPassed"
+	MessageCommandTime         = "command.time"
+	MessageCommandNoPb         = "command.noPb"
+	MessageCommandPb           = "command.pb"
+	MessageCommandKicked       = "command.kicked"
+	MessageCommandKickFailed   = "command.kickFailed"
+	MessageModerationMuted     = "moderation.muted"
+	MessageModerationFloodMute = "moderation.floodMute"
+	MessageModerationFlood     = "moderation.flood"
+	MessageModerationLink      = "moderation.link"
+)
+
+// Default translations of the system messages, can be overridden per server in the chat config
+var SystemMessages = map[string]LocalizedMessage{
+	MessageCommandNoPermission: {
+		"en": "You don't have permission to use this command.",
+		"nl": "Je hebt geen toestemming om dit commando te gebruiken.",
+		"de": "Du hast keine Berechtigung, diesen Befehl zu verwenden.",
+		"fr": "Vous n'avez pas la permission d'utiliser cette commande.",
+	},
+	MessageCommandUsage: {
+		"en": "Usage: /{usage}",
+		"nl": "Gebruik: /{usage}",
+		"de": "Verwendung: /{usage}",
+		"fr": "Utilisation : /{usage}",
+	},
+	MessageCommandCooldown: {
+		"en": "Please wait {seconds} seconds before using /{command} again.",
+		"nl": "Wacht {seconds} seconden voordat je /{command} opnieuw gebruikt.",
+		"de": "Bitte warte {seconds} Sekunden, bevor du /{command} erneut verwendest.",
+		"fr": "Veuillez attendre {seconds} secondes avant d'utiliser /{command} à nouveau.",
+	},
+	MessageCommandHelp: {
+		"en": "Available commands: {commands}",
+		"nl": "Beschikbare commando's: {commands}",
+		"de": "Verfügbare Befehle: {commands}",
+		"fr": "Commandes disponibles : {commands}",
+	},
+	MessageCommandSkipVote: {
+		"en": "{nickName}$z voted to skip the map ({votes}/{needed}).",
+		"nl": "{nickName}$z stemde om de map over te slaan ({votes}/{needed}).",
+		"de": "{nickName}$z hat dafür gestimmt, die Map zu überspringen ({votes}/{needed}).",
+		"fr": "{nickName}$z a voté pour passer la map ({votes}/{needed}).",
+	},
+	MessageCommandSkipPassed: {
+		"en": "Vote passed, skipping the map.",
+		"nl": "Stemming geslaagd, de map wordt overgeslagen.",
+		"de": "Abstimmung angenommen, die Map wird übersprungen.",
+		"fr": "Vote accepté, la map est passée.",
+	},
+	MessageCommandTime: {
+		"en": "Server time: {time}",
+		"nl": "Servertijd: {time}",
+		"de": "Serverzeit: {time}",
+		"fr": "Heure du serveur : {time}",
+	},
+	MessageCommandNoPb: {
+		"en": "You don't have a personal best on this map yet.",
+		"nl": "Je hebt nog geen persoonlijk record op deze map.",
+		"de": "Du hast noch keine persönliche Bestzeit auf dieser Map.",
+		"fr": "Vous n'avez pas encore de record personnel sur cette map.",
+	},
+	MessageCommandPb: {
+		"en": "Your personal best: {time}",
+		"nl": "Je persoonlijk record: {time}",
+		"de": "Deine persönliche Bestzeit: {time}",
+		"fr": "Votre record personnel : {time}",
+	},
+	MessageCommandKicked: {
+		"en": "Kicked {login}.",
+		"nl": "{login} is gekickt.",
+		"de": "{login} wurde gekickt.",
+		"fr": "{login} a été expulsé.",
+	},
+	MessageCommandKickFailed: {
+		"en": "Failed to kick {login}.",
+		"nl": "Kicken van {login} is mislukt.",
+		"de": "{login} konnte nicht gekickt werden.",
+		"fr": "Impossible d'expulser {login}.",
+	},
+	MessageModerationMuted: {
+		"en": "You are muted for another {duration}.",
+		"nl": "Je bent nog {duration} gemute.",
+		"de": "Du bist noch {duration} stummgeschaltet.",
+		"fr": "Vous êtes encore muet pendant {duration}.",
+	},
+	MessageModerationFloodMute: {
+		"en": "You have been muted for {seconds} seconds for flooding the chat.",
+		"nl": "Je bent {seconds} seconden gemute voor het spammen van de chat.",
+		"de": "Du wurdest für {seconds} Sekunden wegen Spam stummgeschaltet.",
+		"fr": "Vous avez été rendu muet pendant {seconds} secondes pour flood.",
+	},
+	MessageModerationFlood: {
+		"en": "You are sending messages too fast.",
+		"nl": "Je stuurt te snel berichten.",
+		"de": "Du sendest Nachrichten zu schnell.",
+		"fr": "Vous envoyez des messages trop rapidement.",
+	},
+	MessageModerationLink: {
+		"en": "Links are not allowed in the chat.",
+		"nl": "Links zijn niet toegestaan in de chat.",
+		"de": "Links sind im Chat nicht erlaubt.",
+		"fr": "Les liens ne sont pas autorisés dans le chat.",
+	},
+}
@@ -0,0 +1,239 @@
+package structs
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const maxModerationLogEntries = 1000
+
+type ModerationConfig struct {
+	BannedWords       []string `json:"bannedWords,omitempty"`
+	MaskCharacter     string   `json:"maskCharacter,omitempty"`
+	RateLimit         int      `json:"rateLimit,omitempty"`         // Max messages per rate interval, 0 disables flood protection
+	RateInterval      int      `json:"rateInterval,omitempty"`      // Seconds
+	FloodMuteDuration int      `json:"floodMuteDuration,omitempty"` // Seconds, 0 only drops the messages
+	BlockLinks        bool     `json:"blockLinks"`
+	AllowedDomains    []string `json:"allowedDomains,omitempty"`
+
+	wordFilter *regexp.Regexp // Compiled from the banned words by CompileWordFilter
+}
+
+// Compiles the banned words into the word filter, called whenever the config is updated
+func (c *ModerationConfig) CompileWordFilter() error {
+	patterns := make([]string, 0, len(c.BannedWords))
+	for _, word := range c.BannedWords {
+		if word = strings.TrimSpace(word); word != "" {
+			patterns = append(patterns, regexp.QuoteMeta(word))
+		}
+	}
+
+	if len(patterns) == 0 {
+		c.wordFilter = nil
+		return nil
+	}
+
+	re, err := regexp.Compile(`(?i)\b(?:` + strings.Join(patterns, "|") + `)\b`)
+	if err != nil {
+		return err
+	}
+	c.wordFilter = re
+	return nil
+}
+
+// Returns the compiled word filter, nil without banned words
+func (c *ModerationConfig) WordFilter() *regexp.Regexp {
+	return c.wordFilter
+}
+
+type ModerationAction string
+
+const (
+	ModerationActionMask   ModerationAction = "mask"
+	ModerationActionFlood  ModerationAction = "flood"
+	ModerationActionLink   ModerationAction = "link"
+	ModerationActionMuted  ModerationAction = "muted"
+	ModerationActionMute   ModerationAction = "mute"
+	ModerationActionUnmute ModerationAction = "unmute"
+)
+
+type ModerationLogEntry struct {
+	Time    time.Time        `json:"time"`
+	Login   string           `json:"login"`
+	Action  ModerationAction `json:"action"`
+	Message string           `json:"message,omitempty"`
+	Reason  string           `json:"reason,omitempty"`
+	By      string           `json:"by,omitempty"`
+}
+
+type Mute struct {
+	Login  string    `json:"login"`
+	Until  time.Time `json:"until"`
+	Reason string    `json:"reason,omitempty"`
+	By     string    `json:"by,omitempty"`
+}
+
+// The mutes and audit trail of a server as persisted in moderation.json
+type ModerationState struct {
+	Mutes []Mute               `json:"mutes"`
+	Log   []ModerationLogEntry `json:"log"`
+}
+
+// Moderation holds the mutes and audit trail of a server.
+type Moderation struct {
+	mu    sync.Mutex
+	mutes map[string]Mute
+	log   []ModerationLogEntry
+}
+
+func NewModeration() *Moderation {
+	return &Moderation{
+		mutes: make(map[string]Mute),
+		log:   make([]ModerationLogEntry, 0),
+	}
+}
+
+// Restores the persisted mutes and audit trail, expired mutes are skipped.
+func (m *Moderation) Restore(state ModerationState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, mute := range state.Mutes {
+		if time.Now().Before(mute.Until) {
+			m.mutes[mute.Login] = mute
+		}
+	}
+
+	m.log = append(m.log, state.Log...)
+	if len(m.log) > maxModerationLogEntries {
+		m.log = m.log[len(m.log)-maxModerationLogEntries:]
+	}
+}
+
+// Returns the active mutes and the audit trail to persist.
+func (m *Moderation) State() ModerationState {
+	mutes := m.Mutes()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return ModerationState{
+		Mutes: mutes,
+		Log:   slices.Clone(m.log),
+	}
+}
+
+// Mutes a player for the given duration and records it in the audit trail.
+func (m *Moderation) Mute(login string, duration time.Duration, reason string, by string) Mute {
+	mute := Mute{
+		Login:  login,
+		Until:  time.Now().Add(duration),
+		Reason: reason,
+		By:     by,
+	}
+
+	m.mu.Lock()
+	m.mutes[login] = mute
+	m.mu.Unlock()
+
+	m.Log(ModerationLogEntry{
+		Login:  login,
+		Action: ModerationActionMute,
+		Reason: reason,
+		By:     by,
+	})
+
+	return mute
+}
+
+// Unmutes a player, returns false if the player was not muted.
+func (m *Moderation) Unmute(login string, by string) bool {
+	m.mu.Lock()
+	_, ok := m.mutes[login]
+	delete(m.mutes, login)
+	m.mu.Unlock()
+
+	if ok {
+		m.Log(ModerationLogEntry{
+			Login:  login,
+			Action: ModerationActionUnmute,
+			By:     by,
+		})
+	}
+
+	return ok
+}
+
+// Returns the active mute of a player, expired mutes are removed.
+func (m *Moderation) GetMute(login string) (Mute, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mute, ok := m.mutes[login]
+	if !ok {
+		return Mute{}, false
+	}
+
+	if time.Now().After(mute.Until) {
+		delete(m.mutes, login)
+		return Mute{}, false
+	}
+
+	return mute, true
+}
+
+// Returns all active mutes.
+func (m *Moderation) Mutes() []Mute {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mutes := make([]Mute, 0, len(m.mutes))
+	for login, mute := range m.mutes {
+		if time.Now().After(mute.Until) {
+			delete(m.mutes, login)
+			continue
+		}
+		mutes = append(mutes, mute)
+	}
+
+	slices.SortFunc(mutes, func(a, b Mute) int {
+		return a.Until.Compare(b.Until)
+	})
+	return mutes
+}
+
+// Adds an entry to the audit trail, dropping the oldest entries when full.
+func (m *Moderation) Log(entry ModerationLogEntry) {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log = append(m.log, entry)
+	if len(m.log) > maxModerationLogEntries {
+		m.log = m.log[len(m.log)-maxModerationLogEntries:]
+	}
+}
+
+// Returns the audit trail, optionally filtered by login and action.
+func (m *Moderation) Logs(login string, action ModerationAction) []ModerationLogEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := make([]ModerationLogEntry, 0)
+	for _, entry := range m.log {
+		if login != "" && entry.Login != login {
+			continue
+		}
+		if action != "" && entry.Action != action {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -1,6 +1,10 @@
 package structs
 
-import "github.com/MRegterschot/GbxRemoteGo/structs"
+import (
+	"sync"
+
+	"github.com/MRegterschot/GbxRemoteGo/structs"
+)
 
 type PlayerInfo struct {
 	Login           string `json:"login"`
@@ -8,6 +12,70 @@ type PlayerInfo struct {
 	PlayerId        int    `json:"playerId"`
 	TeamId          int    `json:"teamId"`
 	SpectatorStatus int    `json:"spectatorStatus"`
+	SpectatorInfo
+}
+
+type SpectatorInfo struct {
+	IsSpectator          bool `json:"isSpectator"`
+	IsTemporarySpectator bool `json:"isTemporarySpectator"`
+	IsPureSpectator      bool `json:"isPureSpectator"`
+	AutoTarget           bool `json:"autoTarget"`
+	TargetId             int  `json:"targetId"`
+}
+
+// Player modes accepted by ForceSpectator
+const (
+	PlayerModeUserSelectable = 0
+	PlayerModeSpectator      = 1
+	PlayerModePlayer         = 2
+	PlayerModeSpectatorFree  = 3 // Spectator, but the player can switch back
+)
+
+type PlayerModeRequest struct {
+	Mode string `json:"mode"` // spectator, spectatorFree, player or userSelectable
+}
+
+type SpectatorTargetRequest struct {
+	Target string `json:"target"`           // Login of the player to spectate, empty to clear the target
+	Camera *int   `json:"camera,omitempty"` // -1 leaves the camera unchanged, 0 replay, 1 follow, 2 free
+}
+
+// Most slots the dedicated server accepts for players or spectators
+const MaxServerSlots = 255
+
+type SlotsRequest struct {
+	MaxPlayers    *int `json:"maxPlayers,omitempty"`
+	MaxSpectators *int `json:"maxSpectators,omitempty"`
+}
+
+// Validate checks both values, so none are set when one of them is invalid
+func (r *SlotsRequest) Validate() []FieldError {
+	var errs []FieldError
+
+	if r.MaxPlayers == nil && r.MaxSpectators == nil {
+		errs = append(errs, FieldError{Field: "maxPlayers", Message: "Max players or max spectators is required"})
+	}
+
+	if r.MaxPlayers != nil && (*r.MaxPlayers < 0 || *r.MaxPlayers > MaxServerSlots) {
+		errs = append(errs, FieldError{Field: "maxPlayers", Message: "Max players must be between 0 and 255"})
+	}
+
+	if r.MaxSpectators != nil && (*r.MaxSpectators < 0 || *r.MaxSpectators > MaxServerSlots) {
+		errs = append(errs, FieldError{Field: "maxSpectators", Message: "Max spectators must be between 0 and 255"})
+	}
+
+	return errs
+}
+
+// Decodes the spectator status, every digit holds a flag and the target id starts at the fifth digit
+func DecodeSpectatorStatus(status int) SpectatorInfo {
+	return SpectatorInfo{
+		IsSpectator:          status%10 != 0,
+		IsTemporarySpectator: (status/10)%10 != 0,
+		IsPureSpectator:      (status/100)%10 != 0,
+		AutoTarget:           (status/1000)%10 != 0,
+		TargetId:             status / 10000,
+	}
 }
 
 func ToPlayerInfo(playerInfo structs.TMPlayerInfo) PlayerInfo {
@@ -17,5 +85,59 @@ func ToPlayerInfo(playerInfo structs.TMPlayerInfo) PlayerInfo {
 		PlayerId:        playerInfo.PlayerId,
 		TeamId:          playerInfo.TeamId,
 		SpectatorStatus: playerInfo.SpectatorStatus,
+		SpectatorInfo:   DecodeSpectatorStatus(playerInfo.SpectatorStatus),
+	}
+}
+
+// PlayerLanguages keeps the detected and manually set languages of the players on a server.
+type PlayerLanguages struct {
+	mu       sync.RWMutex
+	detected map[string]string
+	manual   map[string]string
+}
+
+func NewPlayerLanguages() *PlayerLanguages {
+	return &PlayerLanguages{
+		detected: make(map[string]string),
+		manual:   make(map[string]string),
+	}
+}
+
+// Returns the language of the player, a manually set language takes precedence.
+func (l *PlayerLanguages) Get(login string) string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if language, ok := l.manual[login]; ok {
+		return language
 	}
+	return l.detected[login]
+}
+
+func (l *PlayerLanguages) SetDetected(login string, language string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if language == "" {
+		delete(l.detected, login)
+		return
+	}
+	l.detected[login] = BaseLanguage(language)
+}
+
+func (l *PlayerLanguages) SetManual(login string, language string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.manual[login] = BaseLanguage(language)
+}
+
+// Removes the manually set language, returns false if there was none.
+func (l *PlayerLanguages) ClearManual(login string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, ok := l.manual[login]
+	delete(l.manual, login)
+	return ok
 }
@@ -0,0 +1,16 @@
+package structs
+
+import "encoding/json"
+
+type ScriptTriggerRequest struct {
+	Method   string   `json:"method"`
+	Params   []string `json:"params"`
+	Callback string   `json:"callback,omitempty"` // Callback that carries the response, empty to not wait for one
+	Timeout  int      `json:"timeout,omitempty"`  // Milliseconds
+}
+
+type ScriptTriggerResponse struct {
+	ResponseId string          `json:"responseId,omitempty"` // Only set when waiting for a callback
+	Callback   string          `json:"callback,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+}
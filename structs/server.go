@@ -2,19 +2,25 @@ package structs
 
 import (
 	"context"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/MRegterschot/GbxRemoteGo/gbxclient"
 )
 
 type Server struct {
-	Uuid        string  `json:"uuid"`
-	Name        string  `json:"name"`
-	Description *string `json:"description,omitempty"`
-	Host        string  `json:"host"`
-	XMLRPCPort  int     `json:"xmlrpcPort"`
-	User        string  `json:"user"`
-	Pass        string  `json:"pass"`
-	FMUrl       *string `json:"fmUrl,omitempty"`
+	Uuid            string         `json:"uuid"`
+	Name            string         `json:"name"`
+	Description     *string        `json:"description,omitempty"`
+	Host            string         `json:"host"`
+	XMLRPCPort      int            `json:"xmlrpcPort"`
+	User            string         `json:"user"`
+	Pass            string         `json:"pass"`
+	FMUrl           *string        `json:"fmUrl,omitempty"`
+	Admins          []string       `json:"admins,omitempty"`
+	ScriptCallbacks []string       `json:"scriptCallbacks,omitempty"`
+	Discord         *DiscordConfig `json:"discord,omitempty"`
 
 	// Internal
 	Info       *ServerInfo          `json:"-"`
@@ -24,24 +30,57 @@ type Server struct {
 }
 
 type ServerResponse struct {
-	Uuid        string  `json:"uuid"`
-	Name        string  `json:"name"`
-	Description *string `json:"description,omitempty"`
-	Host        string  `json:"host"`
-	XMLRPCPort  int     `json:"xmlrpcPort"`
-	User        string  `json:"user"`
-	Pass        string  `json:"pass"`
-	FMUrl       *string `json:"fmUrl,omitempty"`
-	IsConnected bool    `json:"isConnected"`
+	Uuid            string         `json:"uuid"`
+	Name            string         `json:"name"`
+	Description     *string        `json:"description,omitempty"`
+	Host            string         `json:"host"`
+	XMLRPCPort      int            `json:"xmlrpcPort"`
+	User            string         `json:"user"`
+	Pass            string         `json:"pass"`
+	FMUrl           *string        `json:"fmUrl,omitempty"`
+	Admins          []string       `json:"admins,omitempty"`
+	ScriptCallbacks []string       `json:"scriptCallbacks,omitempty"`
+	Discord         *DiscordConfig `json:"discord,omitempty"`
+	IsConnected     bool           `json:"isConnected"`
+}
+
+// Result of testing the connection to a server before it is saved
+type ServerTestResult struct {
+	Connected     bool   `json:"connected"`
+	Authenticated bool   `json:"authenticated"`
+	Latency       int64  `json:"latency"` // In milliseconds
+	Error         string `json:"error,omitempty"`
+}
+
+// A server definition to import, the id and password keys of the servers template are accepted as well
+type ServerImport struct {
+	Server
+	Id       any    `json:"id,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// An imported server with the same host and port as another server
+type ServerConflict struct {
+	Index      int    `json:"index"` // Index of the server in the import
+	Host       string `json:"host"`
+	XMLRPCPort int    `json:"xmlrpcPort"`
+	ServerUuid string `json:"serverUuid,omitempty"` // Existing server, empty when it conflicts with another imported server
+}
+
+type ServerImportResult struct {
+	Imported []ServerResponse `json:"imported"`
+	Removed  []string         `json:"removed"` // Uuids of the servers removed by a replace
 }
 
 type ServerList []*Server
 
 type ServerInfo struct {
-	ActiveMap     string       `json:"-"`
-	ActivePlayers []PlayerInfo `json:"-"`
-	LiveInfo      *LiveInfo    `json:"-"`
-	Chat          ChatConfig   `json:"-"`
+	ActiveMap     string           `json:"-"`
+	ActivePlayers []PlayerInfo     `json:"-"`
+	LiveInfo      *LiveInfo        `json:"-"`
+	Chat          ChatConfig       `json:"-"`
+	Moderation    *Moderation      `json:"-"`
+	Languages     *PlayerLanguages `json:"-"`
 }
 
 func (s *Server) ToServerResponse() ServerResponse {
@@ -50,39 +89,73 @@ func (s *Server) ToServerResponse() ServerResponse {
 		isConnected = s.Client.IsConnected
 	}
 
+	// The Discord webhook url is a secret, it is never sent back
+	var discord *DiscordConfig
+	if s.Discord != nil {
+		discord = &DiscordConfig{Username: s.Discord.Username, ThumbnailUrl: s.Discord.ThumbnailUrl}
+	}
+
 	return ServerResponse{
-		Uuid:        s.Uuid,
-		Name:        s.Name,
-		Description: s.Description,
-		Host:        s.Host,
-		XMLRPCPort:  s.XMLRPCPort,
-		User:        s.User,
-		Pass:        s.Pass,
-		FMUrl:       s.FMUrl,
-		IsConnected: isConnected,
+		Uuid:            s.Uuid,
+		Name:            s.Name,
+		Description:     s.Description,
+		Host:            s.Host,
+		XMLRPCPort:      s.XMLRPCPort,
+		User:            s.User,
+		Pass:            s.Pass,
+		FMUrl:           s.FMUrl,
+		Admins:          s.Admins,
+		ScriptCallbacks: s.ScriptCallbacks,
+		Discord:         discord,
+		IsConnected:     isConnected,
 	}
 }
 
-func (servers ServerList) ToServerResponses() []ServerResponse {
-	responses := make([]ServerResponse, len(servers))
-	for i, s := range servers {
-		isConnected := false
-		if s.Client != nil {
-			isConnected = s.Client.IsConnected
+// Returns the server with the given uuid, or nil if it doesn't exist.
+func (servers ServerList) GetByUuid(serverUuid string) *Server {
+	for _, s := range servers {
+		if s.Uuid == serverUuid {
+			return s
 		}
+	}
+	return nil
+}
 
-		responses[i] = ServerResponse{
-			Uuid:        s.Uuid,
-			Name:        s.Name,
-			Description: s.Description,
-			Host:        s.Host,
-			XMLRPCPort:  s.XMLRPCPort,
-			User:        s.User,
-			Pass:        s.Pass,
-			FMUrl:       s.FMUrl,
-			IsConnected: isConnected,
+// Returns the imported servers with the same host and port as another existing server or an earlier imported server
+func (servers ServerList) Conflicts(imported []*Server) []ServerConflict {
+	conflicts := []ServerConflict{}
+	for i, server := range imported {
+		conflict := ServerConflict{Index: i, Host: server.Host, XMLRPCPort: server.XMLRPCPort}
+
+		// An existing server with the same uuid is updated by the import
+		if existing := servers.GetByAddress(server.Host, server.XMLRPCPort); existing != nil && existing.Uuid != server.Uuid {
+			conflict.ServerUuid = existing.Uuid
+			conflicts = append(conflicts, conflict)
+			continue
+		}
+
+		if ServerList(imported[:i]).GetByAddress(server.Host, server.XMLRPCPort) != nil {
+			conflicts = append(conflicts, conflict)
 		}
 	}
+	return conflicts
+}
+
+// Returns the server with the given host and port, or nil if it doesn't exist.
+func (servers ServerList) GetByAddress(host string, port int) *Server {
+	for _, s := range servers {
+		if s.Host == host && s.XMLRPCPort == port {
+			return s
+		}
+	}
+	return nil
+}
+
+func (servers ServerList) ToServerResponses() []ServerResponse {
+	responses := make([]ServerResponse, len(servers))
+	for i, s := range servers {
+		responses[i] = s.ToServerResponse()
+	}
 	return responses
 }
 
@@ -97,19 +170,157 @@ func (s *Server) ResetLiveInfo() {
 	}
 
 	if s.Info == nil {
-		s.Info = &ServerInfo{}
+		s.Info = &ServerInfo{
+			Moderation: NewModeration(),
+			Languages:  NewPlayerLanguages(),
+		}
 	}
 	s.Info.LiveInfo = liveInfo
 }
 
-func (s *Server) UpdateServer(name string, description *string, host string, xmlrpcPort int, user, pass string, fmUrl *string) {
-	s.Name = name
-	s.Description = description
-	s.Host = host
-	s.XMLRPCPort = xmlrpcPort
-	s.User = user
-	s.Pass = pass
-	s.FMUrl = fmUrl
+// UpdateServer copies the configuration of the input, a Discord config without a webhook url keeps the current url
+func (s *Server) UpdateServer(input *Server) {
+	updated := s.UpdatedConfig(input)
+
+	s.Name = updated.Name
+	s.Description = updated.Description
+	s.Host = updated.Host
+	s.XMLRPCPort = updated.XMLRPCPort
+	s.User = updated.User
+	s.Pass = updated.Pass
+	s.FMUrl = updated.FMUrl
+	s.Admins = updated.Admins
+	s.ScriptCallbacks = updated.ScriptCallbacks
+	s.Discord = updated.Discord
 
 	s.ResetLiveInfo()
 }
+
+// UpdatedConfig returns the configuration the server gets from UpdateServer, without changing the server
+func (s *Server) UpdatedConfig(input *Server) *Server {
+	return &Server{
+		Uuid:            s.Uuid,
+		Name:            input.Name,
+		Description:     input.Description,
+		Host:            input.Host,
+		XMLRPCPort:      input.XMLRPCPort,
+		User:            input.User,
+		Pass:            input.Pass,
+		FMUrl:           input.FMUrl,
+		Admins:          input.Admins,
+		ScriptCallbacks: input.ScriptCallbacks,
+		Discord:         keepWebhookUrl(input.Discord, s.Discord),
+	}
+}
+
+// KeepSecrets takes the password and Discord webhook url of the existing server when they are left out,
+// like in a redacted export
+func (s *Server) KeepSecrets(existing *Server) {
+	if s.Pass == "" {
+		s.Pass = existing.Pass
+	}
+	s.Discord = keepWebhookUrl(s.Discord, existing.Discord)
+}
+
+// Returns the Discord config with the webhook url of the existing config when it has none
+func keepWebhookUrl(discord *DiscordConfig, existing *DiscordConfig) *DiscordConfig {
+	if discord == nil || discord.WebhookUrl != "" || existing == nil {
+		return discord
+	}
+
+	withUrl := *discord
+	withUrl.WebhookUrl = existing.WebhookUrl
+	return &withUrl
+}
+
+// ToServer returns the server to add, the template password is used when pass is not set
+func (i *ServerImport) ToServer() *Server {
+	server := i.Server
+	if server.Pass == "" {
+		server.Pass = i.Password
+	}
+	return &server
+}
+
+// ToExport returns a copy of the server configuration, with redact the password and Discord webhook are left out
+func (s *Server) ToExport(redact bool) Server {
+	export := Server{
+		Uuid:            s.Uuid,
+		Name:            s.Name,
+		Description:     s.Description,
+		Host:            s.Host,
+		XMLRPCPort:      s.XMLRPCPort,
+		User:            s.User,
+		Pass:            s.Pass,
+		FMUrl:           s.FMUrl,
+		Admins:          s.Admins,
+		ScriptCallbacks: s.ScriptCallbacks,
+		Discord:         s.Discord,
+	}
+
+	if redact {
+		export.Pass = ""
+		if s.Discord != nil {
+			discord := *s.Discord
+			discord.WebhookUrl = ""
+			export.Discord = &discord
+		}
+	}
+	return export
+}
+
+// Validate checks the fields needed to connect to the server, it returns an error for each invalid field
+func (s *Server) Validate() []FieldError {
+	var errs []FieldError
+
+	if strings.TrimSpace(s.Name) == "" {
+		errs = append(errs, FieldError{Field: "name", Message: "Name is required"})
+	}
+
+	if strings.TrimSpace(s.Host) == "" {
+		errs = append(errs, FieldError{Field: "host", Message: "Host is required"})
+	} else if strings.ContainsAny(s.Host, " \t/") {
+		errs = append(errs, FieldError{Field: "host", Message: "Host must be a hostname or IP address without scheme or path"})
+	}
+
+	if s.XMLRPCPort < 1 || s.XMLRPCPort > 65535 {
+		errs = append(errs, FieldError{Field: "xmlrpcPort", Message: "Port must be between 1 and 65535"})
+	}
+
+	if s.User == "" {
+		errs = append(errs, FieldError{Field: "user", Message: "User is required"})
+	}
+
+	if s.Pass == "" {
+		errs = append(errs, FieldError{Field: "pass", Message: "Password is required"})
+	}
+
+	if s.FMUrl != nil && *s.FMUrl != "" {
+		u, err := url.Parse(*s.FMUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, FieldError{Field: "fmUrl", Message: "File manager URL must be an absolute http or https URL"})
+		}
+	}
+
+	return errs
+}
+
+// IsAdmin reports whether the login is in the server's admin list.
+func (s *Server) IsAdmin(login string) bool {
+	return slices.Contains(s.Admins, login)
+}
+
+// Returns the language of a player, falling back to the default language of the server.
+func (s *Server) PlayerLanguage(login string) string {
+	if language := s.Info.Languages.Get(login); language != "" {
+		return language
+	}
+	return s.DefaultLanguage()
+}
+
+func (s *Server) DefaultLanguage() string {
+	if s.Info.Chat.DefaultLanguage != "" {
+		return BaseLanguage(s.Info.Chat.DefaultLanguage)
+	}
+	return DefaultLanguage
+}
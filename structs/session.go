@@ -0,0 +1,224 @@
+package structs
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+type PlayerSession struct {
+	Login          string     `json:"login"`
+	NickName       string     `json:"nickName"`
+	ServerUuid     string     `json:"serverUuid"`
+	ConnectedAt    time.Time  `json:"connectedAt"`
+	DisconnectedAt *time.Time `json:"disconnectedAt,omitempty"`
+	PlayTime       int        `json:"playTime"`      // Seconds
+	SpectatorTime  int        `json:"spectatorTime"` // Seconds
+}
+
+type ServerPlaytime struct {
+	ServerUuid    string `json:"serverUuid"`
+	Sessions      int    `json:"sessions"`
+	PlayTime      int    `json:"playTime"`
+	SpectatorTime int    `json:"spectatorTime"`
+}
+
+type PlayerHistory struct {
+	Login         string           `json:"login"`
+	NickName      string           `json:"nickName"`
+	IsOnline      bool             `json:"isOnline"`
+	Sessions      int              `json:"sessions"`
+	PlayTime      int              `json:"playTime"`
+	SpectatorTime int              `json:"spectatorTime"`
+	FirstSeen     *time.Time       `json:"firstSeen,omitempty"`
+	LastSeen      *time.Time       `json:"lastSeen,omitempty"`
+	Servers       []ServerPlaytime `json:"servers"`
+	History       []PlayerSession  `json:"history"`
+}
+
+// Finished sessions that are kept, the oldest are dropped first
+const maxSessions = 10000
+
+type openSession struct {
+	session   PlayerSession
+	spectator bool
+	since     time.Time // Start of the current playing or spectating period
+}
+
+// SessionStore keeps the sessions of the players on all servers.
+// Only finished sessions are persisted, open sessions are ended when a server disconnects.
+type SessionStore struct {
+	mu       sync.Mutex
+	sessions []PlayerSession
+	open     map[string]*openSession // Open sessions by server uuid and login
+}
+
+func NewSessionStore(sessions []PlayerSession) *SessionStore {
+	return &SessionStore{
+		sessions: capSessions(sessions),
+		open:     make(map[string]*openSession),
+	}
+}
+
+func sessionKey(serverUuid string, login string) string {
+	return serverUuid + ":" + login
+}
+
+// Starts a session for the player, an open session only gets its nickname and spectator status updated.
+func (s *SessionStore) Start(serverUuid string, login string, nickName string, spectator bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if open, ok := s.open[sessionKey(serverUuid, login)]; ok {
+		open.session.NickName = nickName
+		open.setSpectator(spectator, time.Now())
+		return
+	}
+
+	now := time.Now()
+	s.open[sessionKey(serverUuid, login)] = &openSession{
+		session: PlayerSession{
+			Login:       login,
+			NickName:    nickName,
+			ServerUuid:  serverUuid,
+			ConnectedAt: now,
+		},
+		spectator: spectator,
+		since:     now,
+	}
+}
+
+// Ends the open session of the player, returns false if there was none
+func (s *SessionStore) End(serverUuid string, login string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.end(sessionKey(serverUuid, login), time.Now())
+}
+
+// Ends all open sessions on the server, returns false if there were none
+func (s *SessionStore) EndServer(serverUuid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	ended := false
+	for key, open := range s.open {
+		if open.session.ServerUuid == serverUuid {
+			ended = s.end(key, now) || ended
+		}
+	}
+	return ended
+}
+
+func (s *SessionStore) end(key string, now time.Time) bool {
+	open, ok := s.open[key]
+	if !ok {
+		return false
+	}
+
+	session := open.snapshot(now)
+	session.DisconnectedAt = &now
+
+	delete(s.open, key)
+	s.sessions = capSessions(append(s.sessions, session))
+	return true
+}
+
+// Drops the oldest sessions above the maximum, the sessions are stored in the order they ended
+func capSessions(sessions []PlayerSession) []PlayerSession {
+	if len(sessions) <= maxSessions {
+		return sessions
+	}
+	return slices.Clone(sessions[len(sessions)-maxSessions:])
+}
+
+// Returns the finished sessions
+func (s *SessionStore) Sessions() []PlayerSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.sessions)
+}
+
+// Returns the sessions and playtime of the player on all servers, newest session first
+func (s *SessionStore) History(login string) PlayerHistory {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history := PlayerHistory{
+		Login:   login,
+		Servers: []ServerPlaytime{},
+		History: []PlayerSession{},
+	}
+
+	for _, session := range s.sessions {
+		if session.Login == login {
+			history.History = append(history.History, session)
+		}
+	}
+
+	now := time.Now()
+	for _, open := range s.open {
+		if open.session.Login == login {
+			history.IsOnline = true
+			history.History = append(history.History, open.snapshot(now))
+		}
+	}
+
+	slices.SortFunc(history.History, func(a, b PlayerSession) int {
+		return b.ConnectedAt.Compare(a.ConnectedAt)
+	})
+
+	for i, session := range history.History {
+		history.Sessions++
+		history.PlayTime += session.PlayTime
+		history.SpectatorTime += session.SpectatorTime
+
+		if i == 0 {
+			history.NickName = session.NickName
+			history.LastSeen = &now
+			if session.DisconnectedAt != nil {
+				history.LastSeen = session.DisconnectedAt
+			}
+		}
+		history.FirstSeen = &session.ConnectedAt
+
+		j := slices.IndexFunc(history.Servers, func(sp ServerPlaytime) bool {
+			return sp.ServerUuid == session.ServerUuid
+		})
+		if j < 0 {
+			history.Servers = append(history.Servers, ServerPlaytime{ServerUuid: session.ServerUuid})
+			j = len(history.Servers) - 1
+		}
+
+		history.Servers[j].Sessions++
+		history.Servers[j].PlayTime += session.PlayTime
+		history.Servers[j].SpectatorTime += session.SpectatorTime
+	}
+
+	return history
+}
+
+// Adds the time since the last switch to the playing or spectating time
+func (o *openSession) setSpectator(spectator bool, now time.Time) {
+	if spectator == o.spectator {
+		return
+	}
+
+	o.session = o.snapshot(now)
+	o.spectator = spectator
+	o.since = now
+}
+
+// Returns the session with the time of the current period included
+func (o *openSession) snapshot(now time.Time) PlayerSession {
+	session := o.session
+	elapsed := int(now.Sub(o.since).Seconds())
+	if o.spectator {
+		session.SpectatorTime += elapsed
+	} else {
+		session.PlayTime += elapsed
+	}
+	return session
+}
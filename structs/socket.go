@@ -9,4 +9,4 @@ import (
 type SocketClients struct {
 	Clients   map[*websocket.Conn]bool // Connected clients
 	ClientsMu sync.Mutex
-}
\ No newline at end of file
+}
@@ -0,0 +1,86 @@
+package structs
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookEventPlayerConnect WebhookEvent = "playerConnect"
+	WebhookEventMapStart      WebhookEvent = "mapStart"
+	WebhookEventRoundEnd      WebhookEvent = "roundEnd"
+	WebhookEventMatchEnd      WebhookEvent = "matchEnd"
+	WebhookEventRecord        WebhookEvent = "record"
+)
+
+var WebhookEvents = []WebhookEvent{
+	WebhookEventPlayerConnect,
+	WebhookEventMapStart,
+	WebhookEventRoundEnd,
+	WebhookEventMatchEnd,
+	WebhookEventRecord,
+}
+
+type Webhook struct {
+	Id         string         `json:"id"`
+	Name       string         `json:"name,omitempty"`
+	Url        string         `json:"url"`
+	Secret     string         `json:"secret,omitempty"`     // Used to sign the payloads with HMAC-SHA256
+	Servers    []string       `json:"servers,omitempty"`    // Server uuids, empty for all servers
+	Events     []WebhookEvent `json:"events,omitempty"`     // Empty for all events
+	MaxRetries int            `json:"maxRetries,omitempty"` // Retries after the first attempt, 0 uses the default
+}
+
+// Webhook as returned by the API, the secret is never sent back
+type WebhookResponse struct {
+	Id         string         `json:"id"`
+	Name       string         `json:"name,omitempty"`
+	Url        string         `json:"url"`
+	HasSecret  bool           `json:"hasSecret"`
+	Servers    []string       `json:"servers,omitempty"`
+	Events     []WebhookEvent `json:"events,omitempty"`
+	MaxRetries int            `json:"maxRetries,omitempty"`
+}
+
+func (w *Webhook) ToWebhookResponse() WebhookResponse {
+	return WebhookResponse{
+		Id:         w.Id,
+		Name:       w.Name,
+		Url:        w.Url,
+		HasSecret:  w.Secret != "",
+		Servers:    w.Servers,
+		Events:     w.Events,
+		MaxRetries: w.MaxRetries,
+	}
+}
+
+// Whether the webhook is subscribed to the event on the server
+func (w *Webhook) Matches(serverUuid string, event WebhookEvent) bool {
+	if len(w.Servers) > 0 && !slices.Contains(w.Servers, serverUuid) {
+		return false
+	}
+	return len(w.Events) == 0 || slices.Contains(w.Events, event)
+}
+
+type WebhookPayload struct {
+	Id         string       `json:"id"` // Delivery id, the same for every attempt
+	Event      WebhookEvent `json:"event"`
+	ServerUuid string       `json:"serverUuid"`
+	Time       time.Time    `json:"time"`
+	Data       any          `json:"data"`
+}
+
+// A delivery that failed after all retries
+type DeadLetter struct {
+	WebhookId  string          `json:"webhookId"`
+	Url        string          `json:"url"`
+	Event      WebhookEvent    `json:"event"`
+	ServerUuid string          `json:"serverUuid"`
+	Payload    json.RawMessage `json:"payload"`
+	Attempts   int             `json:"attempts"`
+	Error      string          `json:"error"`
+	Time       time.Time       `json:"time"`
+}